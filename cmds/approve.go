@@ -0,0 +1,88 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type ApproveCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender            currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract          currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of dao" required:"true"`
+	AllowanceCurrency currencycmds.CurrencyIDFlag `arg:"" name:"allowance-currency-id" help:"currency id of allowance" required:"true"`
+	Amount            currencycmds.BigFlag        `arg:"" name:"amount" help:"allowance amount, zero revokes allowance" required:"true"`
+	Currency          currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Expiry            uint64                      `name:"expiry" help:"expiry of allowance in unix time seconds, 0 means no expiry" default:"0"`
+	sender            base.Address
+	contract          base.Address
+}
+
+func (cmd *ApproveCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *ApproveCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	return nil
+}
+
+func (cmd *ApproveCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create approve operation")
+
+	fact := dao.NewApproveFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.AllowanceCurrency.CID,
+		cmd.Amount.Big,
+		cmd.Expiry,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewApprove(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
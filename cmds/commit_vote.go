@@ -0,0 +1,97 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type CommitVoteCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string                      `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Vote       string                      `arg:"" name:"vote" help:"vote option; for | against for crypto proposal, option number for biz and hybrid proposal, or abstain" required:"true"`
+	Options    uint8                       `name:"options" help:"number of vote options of proposal; abstain is the last option" default:"3"`
+	Ballot     []uint8                     `name:"ballot" help:"approved or ranked vote options; approval | ranked-choice proposal"`
+	Salt       string                      `arg:"" name:"salt" help:"salt of the vote commitment; keep it to reveal the vote" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender     base.Address
+	contract   base.Address
+	vote       uint8
+}
+
+func (cmd *CommitVoteCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *CommitVoteCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	vote, err := types.ParseVoteOption(cmd.Vote, cmd.Options)
+	if err != nil {
+		return err
+	}
+	cmd.vote = vote
+
+	return nil
+}
+
+func (cmd *CommitVoteCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create commit-vote operation")
+
+	fact := dao.NewCommitVoteFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		types.NewVoteCommitment(cmd.sender, cmd.ProposalID, cmd.vote, types.Ballot(cmd.Ballot), cmd.Salt),
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewCommitVote(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -1,13 +1,31 @@
 package cmds
 
 type DAOCommand struct {
-	CreateDAO      CreateDAOCommand      `cmd:"" name:"create-dao" help:"create dao to contract account"`
-	UpdatePolicy   UpdatePolicyCommand   `cmd:"" name:"update-policy" help:"update dao policy"`
-	Propose        ProposeCommand        `cmd:"" name:"propose" help:"propose new proposal"`
-	CancelProposal CancelProposalCommand `cmd:"" name:"cancel-proposal" help:"cancel proposal"`
-	Register       RegisterCommand       `cmd:"" name:"register" help:"register to vote"`
-	PreSnap        PreSnapCommand        `cmd:"" name:"pre-snap" help:"snap voting powers"`
-	Vote           VoteCommand           `cmd:"" name:"vote" help:"vote to proposal"`
-	PostSnap       PostSnapCommand       `cmd:"" name:"post-snap" help:"snap voting powers"`
-	Execute        ExecuteCommand        `cmd:"" name:"execute" help:"execute proposal"`
+	CreateDAO             CreateDAOCommand             `cmd:"" name:"create-dao" help:"create dao to contract account"`
+	UpdatePolicy          UpdatePolicyCommand          `cmd:"" name:"update-policy" help:"update dao policy"`
+	Propose               ProposeCommand               `cmd:"" name:"propose" help:"propose new proposal"`
+	CancelProposal        CancelProposalCommand        `cmd:"" name:"cancel-proposal" help:"cancel proposal"`
+	Register              RegisterCommand              `cmd:"" name:"register" help:"register to vote"`
+	SignRegisterItem      SignRegisterItemCommand      `cmd:"" name:"sign-register-item" help:"sign registration of delegator to be registered by register-items"`
+	RegisterItems         RegisterItemsCommand         `cmd:"" name:"register-items" help:"register many delegators to vote"`
+	Unregister            UnregisterCommand            `cmd:"" name:"unregister" help:"cancel registration to vote"`
+	Redelegate            RedelegateCommand            `cmd:"" name:"redelegate" help:"change delegated account of registration"`
+	PreSnap               PreSnapCommand               `cmd:"" name:"pre-snap" help:"snap voting powers"`
+	Vote                  VoteCommand                  `cmd:"" name:"vote" help:"vote to proposal"`
+	RevokeVote            RevokeVoteCommand            `cmd:"" name:"revoke-vote" help:"revoke vote to proposal"`
+	SplitVote             SplitVoteCommand             `cmd:"" name:"split-vote" help:"split vote to multiple options of proposal"`
+	CommitVote            CommitVoteCommand            `cmd:"" name:"commit-vote" help:"commit secret vote to commit-reveal voting proposal"`
+	RevealVote            RevealVoteCommand            `cmd:"" name:"reveal-vote" help:"reveal committed vote to commit-reveal voting proposal"`
+	SubmitEncryptedVote   SubmitEncryptedVoteCommand   `cmd:"" name:"submit-encrypted-vote" help:"submit encrypted vote to encrypted voting proposal"`
+	SubmitDecryptionShare SubmitDecryptionShareCommand `cmd:"" name:"submit-decryption-share" help:"submit decryption shares of encrypted tally as tally committee member"`
+	SignBallot            SignBallotCommand            `cmd:"" name:"sign-ballot" help:"sign ballot of voter to be relayed"`
+	RelayVotes            RelayVotesCommand            `cmd:"" name:"relay-votes" help:"relay ballots signed by voters to proposal"`
+	PostSnap              PostSnapCommand              `cmd:"" name:"post-snap" help:"snap voting powers"`
+	Veto                  VetoCommand                  `cmd:"" name:"veto" help:"veto completed proposal as guardian"`
+	Execute               ExecuteCommand               `cmd:"" name:"execute" help:"execute proposal"`
+	Lock                  LockCommand                  `cmd:"" name:"lock" help:"lock voting power token to dao"`
+	Unlock                UnlockCommand                `cmd:"" name:"unlock" help:"unlock voting power token from dao"`
+	Delegate              DelegateCommand              `cmd:"" name:"delegate" help:"delegate voting power for all proposals"`
+	Undelegate            UndelegateCommand            `cmd:"" name:"undelegate" help:"cancel delegation for all proposals"`
+	Approve               ApproveCommand               `cmd:"" name:"approve" help:"approve allowance of transfer calldata to dao"`
 }
@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type DelegateCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender    currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract  currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Delegatee currencycmds.AddressFlag    `arg:"" name:"delegatee" help:"target address to be delegated" required:"true"`
+	Currency  currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender    base.Address
+	contract  base.Address
+	delegatee base.Address
+}
+
+func (cmd *DelegateCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *DelegateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	delegatee, err := cmd.Delegatee.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid delegatee account format, %q", cmd.Delegatee.String())
+	}
+	cmd.delegatee = delegatee
+
+	return nil
+}
+
+func (cmd *DelegateCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create delegate operation")
+
+	fact := dao.NewDelegateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.delegatee,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewDelegate(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -15,44 +15,104 @@ var SupportedProposalOperationFactHinters []encoder.DecodeDetail
 
 var AddedHinters = []encoder.DecodeDetail{
 	// revive:disable-next-line:line-length-limit
+	{Hint: types.AllowanceHint, Instance: types.Allowance{}},
+	{Hint: types.ApprovalThresholdsHint, Instance: types.ApprovalThresholds{}},
 	{Hint: types.BizProposalHint, Instance: types.BizProposal{}},
 	{Hint: types.CryptoProposalHint, Instance: types.CryptoProposal{}},
+	{Hint: types.DecryptionShareHint, Instance: types.DecryptionShare{}},
 	{Hint: types.DelegatorInfoHint, Instance: types.DelegatorInfo{}},
+	{Hint: types.DepositHint, Instance: types.Deposit{}},
 	{Hint: types.DesignHint, Instance: types.Design{}},
+	{Hint: types.EncryptedBallotHint, Instance: types.EncryptedBallot{}},
+	{Hint: types.FeeSponsorshipHint, Instance: types.FeeSponsorship{}},
+	{Hint: types.GuardianSetHint, Instance: types.GuardianSet{}},
 	{Hint: types.GovernanceCalldataHint, Instance: types.GovernanceCallData{}},
+	{Hint: types.HybridProposalHint, Instance: types.HybridProposal{}},
+	{Hint: types.LockInfoHint, Instance: types.LockInfo{}},
+	{Hint: types.MultiCryptoProposalHint, Instance: types.MultiCryptoProposal{}},
+	{Hint: types.OperationCalldataHint, Instance: types.OperationCallData{}},
 	{Hint: types.PolicyHint, Instance: types.Policy{}},
+	{Hint: types.ProposalMetadataHint, Instance: types.ProposalMetadata{}},
+	{Hint: types.ProposalResultHint, Instance: types.ProposalResult{}},
+	{Hint: types.RelayedBallotHint, Instance: types.RelayedBallot{}},
+	{Hint: types.SponsoredFeeHint, Instance: types.SponsoredFee{}},
+	{Hint: types.TallyCommitteeHint, Instance: types.TallyCommittee{}},
+	{Hint: types.TallyRoundHint, Instance: types.TallyRound{}},
 	{Hint: types.TransferCalldataHint, Instance: types.TransferCallData{}},
+	{Hint: types.VetoRecordHint, Instance: types.VetoRecord{}},
 	{Hint: types.VoterInfoHint, Instance: types.VoterInfo{}},
 	{Hint: types.VotingPowerHint, Instance: types.VotingPower{}},
 	{Hint: types.VotingPowerBoxHint, Instance: types.VotingPowerBox{}},
 	{Hint: types.WhitelistHint, Instance: types.Whitelist{}},
 
+	{Hint: state.AllowanceStateValueHint, Instance: state.AllowanceStateValue{}},
+	{Hint: state.DecryptionSharesStateValueHint, Instance: state.DecryptionSharesStateValue{}},
+	{Hint: state.DelegationStateValueHint, Instance: state.DelegationStateValue{}},
 	{Hint: state.DelegatorsStateValueHint, Instance: state.DelegatorsStateValue{}},
+	{Hint: state.DepositStateValueHint, Instance: state.DepositStateValue{}},
 	{Hint: state.DesignStateValueHint, Instance: state.DesignStateValue{}},
+	{Hint: state.LockStateValueHint, Instance: state.LockStateValue{}},
+	{Hint: state.ProposalResultStateValueHint, Instance: state.ProposalResultStateValue{}},
 	{Hint: state.ProposalStateValueHint, Instance: state.ProposalStateValue{}},
+	{Hint: state.SponsoredFeesStateValueHint, Instance: state.SponsoredFeesStateValue{}},
+	{Hint: state.SponsorshipStateValueHint, Instance: state.SponsorshipStateValue{}},
 	{Hint: state.VotersStateValueHint, Instance: state.VotersStateValue{}},
 	{Hint: state.VotingPowerBoxStateValueHint, Instance: state.VotingPowerBoxStateValue{}},
+	{Hint: state.VetoesStateValueHint, Instance: state.VetoesStateValue{}},
 
+	{Hint: dao.ApproveHint, Instance: dao.Approve{}},
 	{Hint: dao.CancelProposalHint, Instance: dao.CancelProposal{}},
+	{Hint: dao.CommitVoteHint, Instance: dao.CommitVote{}},
 	{Hint: dao.CreateDAOHint, Instance: dao.CreateDAO{}},
+	{Hint: dao.DelegateHint, Instance: dao.Delegate{}},
 	{Hint: dao.ExecuteHint, Instance: dao.Execute{}},
+	{Hint: dao.LockHint, Instance: dao.Lock{}},
 	{Hint: dao.PostSnapHint, Instance: dao.PostSnap{}},
 	{Hint: dao.PreSnapHint, Instance: dao.PreSnap{}},
 	{Hint: dao.ProposeHint, Instance: dao.Propose{}},
+	{Hint: dao.RedelegateHint, Instance: dao.Redelegate{}},
+	{Hint: dao.RelayVotesHint, Instance: dao.RelayVotes{}},
+	{Hint: dao.RevealVoteHint, Instance: dao.RevealVote{}},
+	{Hint: dao.RevokeVoteHint, Instance: dao.RevokeVote{}},
+	{Hint: dao.SplitVoteHint, Instance: dao.SplitVote{}},
+	{Hint: dao.SubmitDecryptionShareHint, Instance: dao.SubmitDecryptionShare{}},
+	{Hint: dao.SubmitEncryptedVoteHint, Instance: dao.SubmitEncryptedVote{}},
 	{Hint: dao.RegisterHint, Instance: dao.Register{}},
+	{Hint: dao.RegisterItemHint, Instance: dao.RegisterItem{}},
+	{Hint: dao.RegisterItemsHint, Instance: dao.RegisterItems{}},
+	{Hint: dao.UndelegateHint, Instance: dao.Undelegate{}},
+	{Hint: dao.UnlockHint, Instance: dao.Unlock{}},
+	{Hint: dao.UnregisterHint, Instance: dao.Unregister{}},
 	{Hint: dao.UpdatePolicyHint, Instance: dao.UpdatePolicy{}},
+	{Hint: dao.VetoHint, Instance: dao.Veto{}},
 	{Hint: dao.VoteHint, Instance: dao.Vote{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
+	{Hint: dao.ApproveFactHint, Instance: dao.ApproveFact{}},
 	{Hint: dao.CancelProposalFactHint, Instance: dao.CancelProposalFact{}},
+	{Hint: dao.CommitVoteFactHint, Instance: dao.CommitVoteFact{}},
 	{Hint: dao.CreateDAOFactHint, Instance: dao.CreateDAOFact{}},
+	{Hint: dao.DelegateFactHint, Instance: dao.DelegateFact{}},
 	{Hint: dao.ExecuteFactHint, Instance: dao.ExecuteFact{}},
+	{Hint: dao.LockFactHint, Instance: dao.LockFact{}},
 	{Hint: dao.PostSnapFactHint, Instance: dao.PostSnapFact{}},
 	{Hint: dao.PreSnapFactHint, Instance: dao.PreSnapFact{}},
 	{Hint: dao.ProposeFactHint, Instance: dao.ProposeFact{}},
+	{Hint: dao.RedelegateFactHint, Instance: dao.RedelegateFact{}},
+	{Hint: dao.RelayVotesFactHint, Instance: dao.RelayVotesFact{}},
+	{Hint: dao.RevealVoteFactHint, Instance: dao.RevealVoteFact{}},
+	{Hint: dao.RevokeVoteFactHint, Instance: dao.RevokeVoteFact{}},
+	{Hint: dao.SplitVoteFactHint, Instance: dao.SplitVoteFact{}},
+	{Hint: dao.SubmitDecryptionShareFactHint, Instance: dao.SubmitDecryptionShareFact{}},
+	{Hint: dao.SubmitEncryptedVoteFactHint, Instance: dao.SubmitEncryptedVoteFact{}},
 	{Hint: dao.RegisterFactHint, Instance: dao.RegisterFact{}},
+	{Hint: dao.RegisterItemsFactHint, Instance: dao.RegisterItemsFact{}},
+	{Hint: dao.UndelegateFactHint, Instance: dao.UndelegateFact{}},
+	{Hint: dao.UnlockFactHint, Instance: dao.UnlockFact{}},
+	{Hint: dao.UnregisterFactHint, Instance: dao.UnregisterFact{}},
 	{Hint: dao.UpdatePolicyFactHint, Instance: dao.UpdatePolicyFact{}},
+	{Hint: dao.VetoFactHint, Instance: dao.VetoFact{}},
 	{Hint: dao.VoteFactHint, Instance: dao.VoteFact{}},
 }
 
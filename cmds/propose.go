@@ -2,6 +2,9 @@ package cmds
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
@@ -30,19 +33,82 @@ type GovernanceCallDataCommand struct {
 	ExecutionDelayPeriod uint64                          `name:"execution-delay-period" help:"execution delay period"`
 	Turnout              uint                            `name:"turnout" help:"turnout"`
 	Quorum               uint                            `name:"quorum" help:"quorum"`
+	AllowVoteChange      bool                            `name:"allow-vote-change" help:"allow voters to change or revoke their vote"`
+	VotingWeightMode     string                          `name:"voting-weight-mode" help:"voting weight mode; linear | quadratic" default:"linear"`
+	EscrowDeposit        bool                            `name:"escrow-deposit" help:"escrow threshold amount as proposal deposit"`
+	Guardians            []string                        `name:"guardian" help:"guardian account which can veto completed proposals"`
+	GuardianThreshold    uint                            `name:"guardian-threshold" help:"number of guardian vetoes to veto a proposal"`
+	PeriodUnit           string                          `name:"period-unit" help:"unit of lifecycle periods and proposal start; second | height" default:"second"`
+	ApprovalThresholds   []string                        `name:"approval-threshold" help:"approval ratio of crypto proposals by calldata type (ex: \"governance:66\")"`
+	AbstainMode          string                          `name:"abstain-mode" help:"whether abstaining votes count toward the quorum; quorum | ignore" default:"quorum"`
+	ApprovalBase         string                          `name:"approval-base" help:"votes the approving votes of crypto proposals are measured against; for-against | cast" default:"for-against"`
+	AntiSnipingWindow    uint64                          `name:"anti-sniping-window" help:"last part of the voting period where a vote flipping the leading option extends the voting period"`
+	AntiSnipingExtension uint64                          `name:"anti-sniping-extension" help:"voting period extension by a vote flipping the leading option in the anti sniping window"`
+	RevealPeriod         uint64                          `name:"reveal-period" help:"reveal period of commit-reveal voting; zero for open voting"`
+	TallyCommittee       []string                        `name:"tally-committee" help:"tally committee member decrypting encrypted ballots with the verification key of its key share (ex: \"<account>:<verification key>\")"`
+	TallyPublicKey       string                          `name:"tally-public-key" help:"public key of the tally committee encrypting ballots"`
+	TallyThreshold       uint                            `name:"tally-threshold" help:"number of tally committee members to decrypt the tally"`
+	RelayFeeReimbursed   bool                            `name:"relay-fee-reimbursed" help:"reimburse the fee of relayed votes from the dao treasury"`
+	SponsorCurrency      string                          `name:"sponsor-currency" help:"currency of the operation fees sponsored by the dao treasury"`
+	SponsorAccountCap    string                          `name:"sponsor-account-cap" help:"maximum fees sponsored per account per proposal"`
+	SponsorBudget        string                          `name:"sponsor-budget" help:"total budget of the operation fees sponsored by the dao treasury"`
 	Whitelist            currencycmds.AddressFlag        `name:"whitelist" help:"whitelist account"`
 }
 
 type CryptoProposalCommand struct {
 	CalldataOption string `name:"calldata-option" help:"calldata option; transfer | governance"`
+	Actions        string `name:"actions" help:"json file of the calldata list for a multi-action proposal or of the calldata of each option for a hybrid proposal"`
 	TransferCallDataCommand
 	GovernanceCallDataCommand
 }
 
 type BizProposalCommand struct {
-	URL     types.URL `name:"url" help:"proposal url"`
-	Hash    string    `name:"hash" help:"proposal hash"`
-	Options uint8     `name:"options" help:"number of vote options"`
+	URL          types.URL `name:"url" help:"proposal url"`
+	Hash         string    `name:"hash" help:"proposal hash"`
+	Options      uint8     `name:"options" help:"number of vote options"`
+	VotingMethod string    `name:"voting-method" help:"voting method; plurality | approval | ranked" default:"plurality"`
+}
+
+type ProposalMetadataCommand struct {
+	Title         string   `name:"title" help:"proposal title"`
+	Summary       string   `name:"summary" help:"proposal summary"`
+	OptionLabels  []string `name:"option-label" help:"label of each vote option in order"`
+	ContentFile   string   `name:"content-file" help:"proposal document file to hash into the content hash"`
+	HashAlgorithm string   `name:"hash-algorithm" help:"content hash algorithm; sha256 | multihash" default:"sha256"`
+}
+
+// metadata builds the proposal metadata, hashing the content file with the
+// hash algorithm.
+func (cmd ProposalMetadataCommand) metadata() (types.ProposalMetadata, error) {
+	var algorithm types.HashAlgorithm
+	var contentHash string
+
+	if len(cmd.ContentFile) > 0 {
+		b, err := os.ReadFile(cmd.ContentFile)
+		if err != nil {
+			return types.ProposalMetadata{}, errors.Wrapf(err, "failed to read content file, %q", cmd.ContentFile)
+		}
+
+		algorithm = types.HashAlgorithm(cmd.HashAlgorithm)
+		sum := sha256.Sum256(b)
+
+		switch algorithm {
+		case types.HashAlgorithmSHA256:
+			contentHash = hex.EncodeToString(sum[:])
+		case types.HashAlgorithmMultihash:
+			// NOTE sha2-256 multihash; code 0x12 and digest length 0x20
+			contentHash = hex.EncodeToString(append([]byte{0x12, 0x20}, sum[:]...))
+		default:
+			return types.ProposalMetadata{}, algorithm.IsValid(nil)
+		}
+	}
+
+	metadata := types.NewProposalMetadata(cmd.Title, cmd.Summary, cmd.OptionLabels, algorithm, contentHash)
+	if err := metadata.IsValid(nil); err != nil {
+		return types.ProposalMetadata{}, err
+	}
+
+	return metadata, nil
 }
 
 type ProposeCommand struct {
@@ -50,11 +116,12 @@ type ProposeCommand struct {
 	currencycmds.OperationFlags
 	Sender     currencycmds.AddressFlag `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract   currencycmds.AddressFlag `arg:"" name:"contract" help:"contract address of credential" required:"true"`
-	Option     types.DAOOption          `arg:"" name:"option" help:"propose option; crypto | biz" required:"true"`
+	Option     types.DAOOption          `arg:"" name:"option" help:"propose option; crypto | biz | hybrid" required:"true"`
 	ProposalID string                   `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
-	StartTime  uint64                   `arg:"" name:"start-time" help:"start time to proposal lifecycle" required:"true"`
+	StartTime  uint64                   `arg:"" name:"start-time" help:"start time to proposal lifecycle; block height when the period unit of dao is height" required:"true"`
 	CryptoProposalCommand
 	BizProposalCommand
+	ProposalMetadataCommand
 	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
 	sender   base.Address
 	contract base.Address
@@ -97,8 +164,24 @@ func (cmd *ProposeCommand) parseFlags() error {
 	}
 	cmd.contract = contract
 
+	metadata, err := cmd.ProposalMetadataCommand.metadata()
+	if err != nil {
+		return err
+	}
+
 	if cmd.Option == types.ProposalCrypto {
-		if cmd.CalldataOption == types.CalldataTransfer {
+		if len(cmd.Actions) > 0 {
+			callDatas, err := cmd.loadActions()
+			if err != nil {
+				return err
+			}
+
+			proposal := types.NewMultiCryptoProposal(sender, cmd.StartTime, callDatas, metadata)
+			if err := proposal.IsValid(nil); err != nil {
+				return err
+			}
+			cmd.proposal = proposal
+		} else if cmd.CalldataOption == types.CalldataTransfer {
 			from, err := cmd.From.Encode(cmd.Encoders.JSON())
 			if err != nil {
 				return errors.Wrapf(err, "invalid from address format, %q", cmd.From.String())
@@ -116,7 +199,7 @@ func (cmd *ProposeCommand) parseFlags() error {
 				return err
 			}
 
-			proposal := types.NewCryptoProposal(sender, cmd.StartTime, callData)
+			proposal := types.NewCryptoProposal(sender, cmd.StartTime, callData, metadata)
 			if err := proposal.IsValid(nil); err != nil {
 				return err
 			}
@@ -132,6 +215,26 @@ func (cmd *ProposeCommand) parseFlags() error {
 				whitelist = types.NewWhitelist(true, []base.Address{a})
 			}
 
+			guardians, err := parseGuardianSet(cmd.Encoders.JSON(), cmd.Guardians, cmd.GuardianThreshold)
+			if err != nil {
+				return err
+			}
+
+			approvalThresholds, err := parseApprovalThresholds(cmd.ApprovalThresholds)
+			if err != nil {
+				return err
+			}
+
+			tallyCommittee, err := parseTallyCommittee(cmd.Encoders.JSON(), cmd.TallyCommittee, cmd.TallyPublicKey, cmd.TallyThreshold)
+			if err != nil {
+				return err
+			}
+
+			feeSponsorship, err := parseFeeSponsorship(cmd.SponsorCurrency, cmd.SponsorAccountCap, cmd.SponsorBudget)
+			if err != nil {
+				return err
+			}
+
 			fee := currencytypes.NewAmount(cmd.Fee.Big, cmd.Fee.CID)
 
 			policy := types.NewPolicy(
@@ -144,6 +247,20 @@ func (cmd *ProposeCommand) parseFlags() error {
 				cmd.PostSnapshotPeriod,
 				cmd.ExecutionDelayPeriod,
 				types.PercentRatio(cmd.Turnout), types.PercentRatio(cmd.Quorum),
+				cmd.AllowVoteChange,
+				types.VotingWeightMode(cmd.VotingWeightMode),
+				cmd.EscrowDeposit,
+				guardians,
+				types.PeriodUnit(cmd.PeriodUnit),
+				approvalThresholds,
+				types.AbstainMode(cmd.AbstainMode),
+				types.ApprovalBase(cmd.ApprovalBase),
+				cmd.AntiSnipingWindow,
+				cmd.AntiSnipingExtension,
+				cmd.RevealPeriod,
+				tallyCommittee,
+				cmd.RelayFeeReimbursed,
+				feeSponsorship,
 			)
 			if err := policy.IsValid(nil); err != nil {
 				return err
@@ -154,7 +271,7 @@ func (cmd *ProposeCommand) parseFlags() error {
 				return err
 			}
 
-			proposal := types.NewCryptoProposal(sender, cmd.StartTime, calldata)
+			proposal := types.NewCryptoProposal(sender, cmd.StartTime, calldata, metadata)
 			if err := proposal.IsValid(nil); err != nil {
 				return err
 			}
@@ -163,7 +280,22 @@ func (cmd *ProposeCommand) parseFlags() error {
 			return errors.Errorf("invalid calldata option, %s", cmd.CalldataOption)
 		}
 	} else if cmd.Option == types.ProposalBiz {
-		proposal := types.NewBizProposal(sender, cmd.StartTime, cmd.URL, cmd.Hash, cmd.Options)
+		proposal := types.NewBizProposal(
+			sender, cmd.StartTime, cmd.URL, cmd.Hash, cmd.Options, types.VotingMethod(cmd.VotingMethod), metadata,
+		)
+		if err := proposal.IsValid(nil); err != nil {
+			return err
+		}
+		cmd.proposal = proposal
+	} else if cmd.Option == types.ProposalHybrid {
+		callDatas, err := cmd.loadActions()
+		if err != nil {
+			return err
+		}
+
+		proposal := types.NewHybridProposal(
+			sender, cmd.StartTime, callDatas, types.VotingMethod(cmd.VotingMethod), metadata,
+		)
 		if err := proposal.IsValid(nil); err != nil {
 			return err
 		}
@@ -175,6 +307,31 @@ func (cmd *ProposeCommand) parseFlags() error {
 	return nil
 }
 
+// loadActions reads the json list of hinted calldata from the actions file.
+func (cmd *ProposeCommand) loadActions() ([]types.CallData, error) {
+	b, err := os.ReadFile(cmd.Actions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read actions file, %q", cmd.Actions)
+	}
+
+	hinters, err := cmd.Encoders.JSON().DecodeSlice(b)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode actions file, %q", cmd.Actions)
+	}
+
+	callDatas := make([]types.CallData, len(hinters))
+	for i, hinter := range hinters {
+		cd, ok := hinter.(types.CallData)
+		if !ok {
+			return nil, errors.Errorf("expected CallData in actions file, not %T", hinter)
+		}
+
+		callDatas[i] = cd
+	}
+
+	return callDatas, nil
+}
+
 func (cmd *ProposeCommand) createOperation() (base.Operation, error) { // nolint:dupl}
 	e := util.StringError("failed to create propose operation")
 
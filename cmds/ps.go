@@ -83,7 +83,97 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		return pctx, err
 	} else if err := opr.SetProcessor(
 		dao.ExecuteHint,
-		dao.NewExecuteProcessor(db.LastBlockMap),
+		dao.NewExecuteProcessor(
+			db.LastBlockMap,
+			func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+				return opr.New(
+					height,
+					getStatef,
+					nil,
+					nil,
+				)
+			},
+		),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.LockHint,
+		dao.NewLockProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.UnlockHint,
+		dao.NewUnlockProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.DelegateHint,
+		dao.NewDelegateProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.UndelegateHint,
+		dao.NewUndelegateProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.UnregisterHint,
+		dao.NewUnregisterProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.RedelegateHint,
+		dao.NewRedelegateProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.RevokeVoteHint,
+		dao.NewRevokeVoteProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.SplitVoteHint,
+		dao.NewSplitVoteProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.ApproveHint,
+		dao.NewApproveProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.VetoHint,
+		dao.NewVetoProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.CommitVoteHint,
+		dao.NewCommitVoteProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.RevealVoteHint,
+		dao.NewRevealVoteProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.SubmitEncryptedVoteHint,
+		dao.NewSubmitEncryptedVoteProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.SubmitDecryptionShareHint,
+		dao.NewSubmitDecryptionShareProcessor(db.LastBlockMap),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.RelayVotesHint,
+		dao.NewRelayVotesProcessor(db.LastBlockMap, isaacParams.NetworkID()),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		dao.RegisterItemsHint,
+		dao.NewRegisterItemsProcessor(db.LastBlockMap, isaacParams.NetworkID()),
 	); err != nil {
 		return pctx, err
 	}
@@ -178,6 +268,166 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 			)
 		})
 
+	_ = set.Add(dao.LockHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.UnlockHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.DelegateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.UndelegateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.UnregisterHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.RedelegateHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.RevokeVoteHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.SplitVoteHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.ApproveHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.VetoHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.CommitVoteHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.RevealVoteHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.SubmitEncryptedVoteHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.SubmitDecryptionShareHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.RelayVotesHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
+	_ = set.Add(dao.RegisterItemsHint,
+		func(height base.Height, getStatef base.GetStateFunc) (base.OperationProcessor, error) {
+			return opr.New(
+				height,
+				getStatef,
+				nil,
+				nil,
+			)
+		})
+
 	pctx = context.WithValue(pctx, currencycmds.OperationProcessorContextKey, opr)
 	pctx = context.WithValue(pctx, launch.OperationProcessorsMapContextKey, set) //revive:disable-line:modifies-parameter
 
@@ -0,0 +1,125 @@
+package cmds
+
+import (
+	"context"
+	"strings"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type RegisterItemsCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Items       []string                    `name:"item" help:"unsigned item authorized by sender or delegation; <proposal-id>,<delegator>,<delegated>"`
+	SignedItems []string                    `name:"signed-item" help:"item json signed by delegator; see sign-register-item"`
+	sender      base.Address
+	contract    base.Address
+	items       []dao.RegisterItem
+}
+
+func (cmd *RegisterItemsCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RegisterItemsCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	items := make([]dao.RegisterItem, 0, len(cmd.Items)+len(cmd.SignedItems))
+	for _, s := range cmd.Items {
+		l := strings.Split(s, ",")
+		if len(l) != 3 {
+			return errors.Errorf("invalid item, %q", s)
+		}
+
+		delegator, err := base.DecodeAddress(l[1], cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid delegator format, %q", l[1])
+		}
+
+		delegated, err := base.DecodeAddress(l[2], cmd.Encoders.JSON())
+		if err != nil {
+			return errors.Wrapf(err, "invalid delegated account format, %q", l[2])
+		}
+
+		items = append(items, dao.NewRegisterItem(l[0], delegator, delegated, nil))
+	}
+
+	for _, s := range cmd.SignedItems {
+		hinter, err := cmd.Encoders.JSON().Decode([]byte(s))
+		if err != nil {
+			return errors.Wrapf(err, "invalid signed item, %q", s)
+		}
+
+		it, ok := hinter.(dao.RegisterItem)
+		if !ok {
+			return errors.Errorf("expected RegisterItem, not %T", hinter)
+		}
+		items = append(items, it)
+	}
+
+	if len(items) < 1 {
+		return errors.Errorf("empty items")
+	}
+	cmd.items = items
+
+	return nil
+}
+
+func (cmd *RegisterItemsCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create register-items operation")
+
+	fact := dao.NewRegisterItemsFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.items,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewRegisterItems(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
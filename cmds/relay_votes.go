@@ -0,0 +1,103 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type RelayVotesCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"relayer address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string                      `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Ballots    []string                    `name:"ballot" help:"ballot json signed by voter; see sign-ballot" required:"true"`
+	sender     base.Address
+	contract   base.Address
+	ballots    []types.RelayedBallot
+}
+
+func (cmd *RelayVotesCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *RelayVotesCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	ballots := make([]types.RelayedBallot, len(cmd.Ballots))
+	for i, s := range cmd.Ballots {
+		hinter, err := cmd.Encoders.JSON().Decode([]byte(s))
+		if err != nil {
+			return errors.Wrapf(err, "invalid ballot, %q", s)
+		}
+
+		rb, ok := hinter.(types.RelayedBallot)
+		if !ok {
+			return errors.Errorf("expected RelayedBallot, not %T", hinter)
+		}
+		ballots[i] = rb
+	}
+	cmd.ballots = ballots
+
+	return nil
+}
+
+func (cmd *RelayVotesCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create relay-votes operation")
+
+	fact := dao.NewRelayVotesFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		cmd.ballots,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewRelayVotes(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
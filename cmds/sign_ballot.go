@@ -0,0 +1,70 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type SignBallotCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Voter      currencycmds.AddressFlag `arg:"" name:"voter" help:"voter address" required:"true"`
+	Contract   currencycmds.AddressFlag `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string                   `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Vote       string                   `arg:"" name:"vote" help:"vote option; for | against for crypto proposal, option number for biz and hybrid proposal, or abstain" required:"true"`
+	Options    uint8                    `name:"options" help:"number of vote options of proposal; abstain is the last option" default:"3"`
+	voter      base.Address
+	contract   base.Address
+	vote       uint8
+}
+
+func (cmd *SignBallotCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	rb, err := types.SignRelayedBallot(
+		cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.voter, cmd.contract, cmd.ProposalID, cmd.vote,
+	)
+	if err != nil {
+		return errors.WithMessage(err, "failed to sign ballot")
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, rb)
+
+	return nil
+}
+
+func (cmd *SignBallotCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	voter, err := cmd.Voter.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid voter format, %q", cmd.Voter.String())
+	}
+	cmd.voter = voter
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	vote, err := types.ParseVoteOption(cmd.Vote, cmd.Options)
+	if err != nil {
+		return err
+	}
+	cmd.vote = vote
+
+	return nil
+}
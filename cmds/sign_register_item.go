@@ -0,0 +1,69 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type SignRegisterItemCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Contract   currencycmds.AddressFlag `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string                   `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Delegator  currencycmds.AddressFlag `arg:"" name:"delegator" help:"delegator address" required:"true"`
+	Delegated  currencycmds.AddressFlag `arg:"" name:"delegated" help:"delegated account address" required:"true"`
+	contract   base.Address
+	delegator  base.Address
+	delegated  base.Address
+}
+
+func (cmd *SignRegisterItemCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	it, err := dao.SignRegisterItem(
+		cmd.Privatekey, cmd.NetworkID.NetworkID(), cmd.contract, cmd.ProposalID, cmd.delegator, cmd.delegated,
+	)
+	if err != nil {
+		return errors.WithMessage(err, "failed to sign register item")
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, it)
+
+	return nil
+}
+
+func (cmd *SignRegisterItemCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	delegator, err := cmd.Delegator.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid delegator format, %q", cmd.Delegator.String())
+	}
+	cmd.delegator = delegator
+
+	delegated, err := cmd.Delegated.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid delegated account format, %q", cmd.Delegated.String())
+	}
+	cmd.delegated = delegated
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package cmds
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type SplitVoteCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string                      `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Allocation []string                    `name:"allocation" help:"vote allocation (ex: \"<option>:<amount>\")" required:"true"`
+	sender     base.Address
+	contract   base.Address
+	alloc      map[uint8]common.Big
+}
+
+func (cmd *SplitVoteCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SplitVoteCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	alloc := map[uint8]common.Big{}
+	for _, a := range cmd.Allocation {
+		l := strings.SplitN(a, ":", 2)
+		if len(l) != 2 {
+			return errors.Errorf("invalid allocation format, %q", a)
+		}
+
+		o, err := strconv.ParseUint(l[0], 10, 8)
+		if err != nil {
+			return errors.Wrapf(err, "invalid allocation option, %q", a)
+		}
+
+		if _, found := alloc[uint8(o)]; found {
+			return errors.Errorf("duplicated allocation option, %q", a)
+		}
+
+		am, err := common.NewBigFromString(l[1])
+		if err != nil {
+			return errors.Wrapf(err, "invalid allocation amount, %q", a)
+		}
+
+		alloc[uint8(o)] = am
+	}
+	cmd.alloc = alloc
+
+	return nil
+}
+
+func (cmd *SplitVoteCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create split-vote operation")
+
+	fact := dao.NewSplitVoteFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		cmd.alloc,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewSplitVote(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -0,0 +1,132 @@
+package cmds
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type SubmitDecryptionShareCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID  string                      `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	SecretShare string                      `name:"secret-share" help:"secret key share of tally committee member" required:"true"`
+	Tally       []string                    `name:"tally" help:"encrypted tally of each option in order (ex: \"<a>:<b>\")" required:"true"`
+	Result      []string                    `name:"result" help:"claimed decrypted result (ex: \"<option>:<amount>\")"`
+	sender      base.Address
+	contract    base.Address
+	shares      []string
+	proofs      []string
+	result      map[uint8]common.Big
+}
+
+func (cmd *SubmitDecryptionShareCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SubmitDecryptionShareCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	shares, proofs, err := types.CreateDecryptionShares(
+		cmd.SecretShare, cmd.Tally,
+		types.DecryptionShareContext(cmd.sender, cmd.ProposalID),
+	)
+	if err != nil {
+		return err
+	}
+	cmd.shares = shares
+	cmd.proofs = proofs
+
+	result := map[uint8]common.Big{}
+	for _, r := range cmd.Result {
+		l := strings.SplitN(r, ":", 2)
+		if len(l) != 2 {
+			return errors.Errorf("invalid result format, %q", r)
+		}
+
+		o, err := strconv.ParseUint(l[0], 10, 8)
+		if err != nil {
+			return errors.Wrapf(err, "invalid result option, %q", r)
+		}
+
+		if _, found := result[uint8(o)]; found {
+			return errors.Errorf("duplicated result option, %q", r)
+		}
+
+		am, err := common.NewBigFromString(l[1])
+		if err != nil {
+			return errors.Wrapf(err, "invalid result amount, %q", r)
+		}
+
+		result[uint8(o)] = am
+	}
+	cmd.result = result
+
+	return nil
+}
+
+func (cmd *SubmitDecryptionShareCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create submit-decryption-share operation")
+
+	fact := dao.NewSubmitDecryptionShareFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		cmd.shares,
+		cmd.proofs,
+		cmd.result,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewSubmitDecryptionShare(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
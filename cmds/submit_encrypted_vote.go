@@ -0,0 +1,104 @@
+package cmds
+
+import (
+	"context"
+
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type SubmitEncryptedVoteCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	ProposalID string                      `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
+	Vote       string                      `arg:"" name:"vote" help:"vote option; for | against for crypto proposal, option number for biz and hybrid proposal, or abstain" required:"true"`
+	Options    uint8                       `arg:"" name:"options" help:"number of vote options of proposal" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	PublicKey  string                      `name:"tally-public-key" help:"public key of tally committee of proposal" required:"true"`
+	sender     base.Address
+	contract   base.Address
+	ballot     types.EncryptedBallot
+}
+
+func (cmd *SubmitEncryptedVoteCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	currencycmds.PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *SubmitEncryptedVoteCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(cmd.Encoders.JSON())
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	vote, err := types.ParseVoteOption(cmd.Vote, cmd.Options)
+	if err != nil {
+		return err
+	}
+
+	ballot, err := types.EncryptBallot(
+		cmd.PublicKey, cmd.Options, vote,
+		types.EncryptedBallotContext(cmd.sender, cmd.ProposalID),
+	)
+	if err != nil {
+		return err
+	}
+	cmd.ballot = ballot
+
+	return nil
+}
+
+func (cmd *SubmitEncryptedVoteCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create submit-encrypted-vote operation")
+
+	fact := dao.NewSubmitEncryptedVoteFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.ProposalID,
+		cmd.ballot,
+		cmd.Currency.CID,
+	)
+
+	op, err := dao.NewSubmitEncryptedVote(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+	err = op.HashSign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
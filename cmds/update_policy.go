@@ -29,11 +29,34 @@ type UpdatePolicyCommand struct {
 	ExecutionDelayPeriod uint64                          `arg:"" name:"execution-delay-period" help:"execution delay period" required:"true"`
 	Turnout              uint                            `arg:"" name:"turnout" help:"turnout" required:"true"`
 	Quorum               uint                            `arg:"" name:"quorum" help:"quorum" required:"true"`
+	AllowVoteChange      bool                            `name:"allow-vote-change" help:"allow voters to change or revoke their vote"`
+	VotingWeightMode     string                          `name:"voting-weight-mode" help:"voting weight mode; linear | quadratic" default:"linear"`
+	EscrowDeposit        bool                            `name:"escrow-deposit" help:"escrow threshold amount as proposal deposit"`
+	Guardians            []string                        `name:"guardian" help:"guardian account which can veto completed proposals"`
+	GuardianThreshold    uint                            `name:"guardian-threshold" help:"number of guardian vetoes to veto a proposal"`
+	PeriodUnit           string                          `name:"period-unit" help:"unit of lifecycle periods and proposal start; second | height" default:"second"`
+	ApprovalThresholds   []string                        `name:"approval-threshold" help:"approval ratio of crypto proposals by calldata type (ex: \"governance:66\")"`
+	AbstainMode          string                          `name:"abstain-mode" help:"whether abstaining votes count toward the quorum; quorum | ignore" default:"quorum"`
+	ApprovalBase         string                          `name:"approval-base" help:"votes the approving votes of crypto proposals are measured against; for-against | cast" default:"for-against"`
+	AntiSnipingWindow    uint64                          `name:"anti-sniping-window" help:"last part of the voting period where a vote flipping the leading option extends the voting period"`
+	AntiSnipingExtension uint64                          `name:"anti-sniping-extension" help:"voting period extension by a vote flipping the leading option in the anti sniping window"`
+	RevealPeriod         uint64                          `name:"reveal-period" help:"reveal period of commit-reveal voting; zero for open voting"`
+	TallyCommittee       []string                        `name:"tally-committee" help:"tally committee member decrypting encrypted ballots with the verification key of its key share (ex: \"<account>:<verification key>\")"`
+	TallyPublicKey       string                          `name:"tally-public-key" help:"public key of the tally committee encrypting ballots"`
+	TallyThreshold       uint                            `name:"tally-threshold" help:"number of tally committee members to decrypt the tally"`
+	RelayFeeReimbursed   bool                            `name:"relay-fee-reimbursed" help:"reimburse the fee of relayed votes from the dao treasury"`
+	SponsorCurrency      string                          `name:"sponsor-currency" help:"currency of the operation fees sponsored by the dao treasury"`
+	SponsorAccountCap    string                          `name:"sponsor-account-cap" help:"maximum fees sponsored per account per proposal"`
+	SponsorBudget        string                          `name:"sponsor-budget" help:"total budget of the operation fees sponsored by the dao treasury"`
 	Whitelist            currencycmds.AddressFlag        `name:"whitelist" help:"whitelist account"`
 	Currency             currencycmds.CurrencyIDFlag     `arg:"" name:"currency-id" help:"currency id" required:"true"`
 	sender               base.Address
 	contract             base.Address
 	whitelist            types.Whitelist
+	guardians            types.GuardianSet
+	approvalThresholds   types.ApprovalThresholds
+	tallyCommittee       types.TallyCommittee
+	feeSponsorship       types.FeeSponsorship
 	fee                  currencytypes.Amount
 }
 
@@ -83,6 +106,30 @@ func (cmd *UpdatePolicyCommand) parseFlags() error {
 		cmd.whitelist = types.NewWhitelist(false, []base.Address{})
 	}
 
+	guardians, err := parseGuardianSet(cmd.Encoders.JSON(), cmd.Guardians, cmd.GuardianThreshold)
+	if err != nil {
+		return err
+	}
+	cmd.guardians = guardians
+
+	approvalThresholds, err := parseApprovalThresholds(cmd.ApprovalThresholds)
+	if err != nil {
+		return err
+	}
+	cmd.approvalThresholds = approvalThresholds
+
+	tallyCommittee, err := parseTallyCommittee(cmd.Encoders.JSON(), cmd.TallyCommittee, cmd.TallyPublicKey, cmd.TallyThreshold)
+	if err != nil {
+		return err
+	}
+	cmd.tallyCommittee = tallyCommittee
+
+	feeSponsorship, err := parseFeeSponsorship(cmd.SponsorCurrency, cmd.SponsorAccountCap, cmd.SponsorBudget)
+	if err != nil {
+		return err
+	}
+	cmd.feeSponsorship = feeSponsorship
+
 	cmd.fee = currencytypes.NewAmount(cmd.Fee.Big, cmd.Fee.CID)
 
 	return nil
@@ -108,6 +155,20 @@ func (cmd *UpdatePolicyCommand) createOperation() (base.Operation, error) { // n
 		cmd.ExecutionDelayPeriod,
 		types.PercentRatio(cmd.Turnout),
 		types.PercentRatio(cmd.Quorum),
+		cmd.AllowVoteChange,
+		types.VotingWeightMode(cmd.VotingWeightMode),
+		cmd.EscrowDeposit,
+		cmd.guardians,
+		types.PeriodUnit(cmd.PeriodUnit),
+		cmd.approvalThresholds,
+		types.AbstainMode(cmd.AbstainMode),
+		types.ApprovalBase(cmd.ApprovalBase),
+		cmd.AntiSnipingWindow,
+		cmd.AntiSnipingExtension,
+		cmd.RevealPeriod,
+		cmd.tallyCommittee,
+		cmd.RelayFeeReimbursed,
+		cmd.feeSponsorship,
 		cmd.Currency.CID,
 	)
 
@@ -1,7 +1,16 @@
 package cmds
 
 import (
+	"strconv"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
 )
 
 func IsSupportedProposalOperationFactHintFunc() func(hint.Hint) bool {
@@ -18,3 +27,96 @@ func IsSupportedProposalOperationFactHintFunc() func(hint.Hint) bool {
 		return false
 	}
 }
+
+func parseGuardianSet(enc encoder.Encoder, guardians []string, threshold uint) (types.GuardianSet, error) {
+	accounts := make([]base.Address, len(guardians))
+	for i := range guardians {
+		a, err := base.DecodeAddress(guardians[i], enc)
+		if err != nil {
+			return types.GuardianSet{}, errors.Wrapf(err, "invalid guardian account format, %q", guardians[i])
+		}
+		accounts[i] = a
+	}
+
+	gs := types.NewGuardianSet(accounts, threshold)
+	if err := gs.IsValid(nil); err != nil {
+		return types.GuardianSet{}, err
+	}
+
+	return gs, nil
+}
+
+func parseApprovalThresholds(thresholds []string) (types.ApprovalThresholds, error) {
+	m := map[string]types.PercentRatio{}
+	for i := range thresholds {
+		l := strings.SplitN(thresholds[i], ":", 2)
+		if len(l) != 2 {
+			return types.ApprovalThresholds{}, errors.Errorf("invalid approval threshold format, %q", thresholds[i])
+		}
+
+		r, err := strconv.ParseUint(l[1], 10, 8)
+		if err != nil {
+			return types.ApprovalThresholds{}, errors.Wrapf(err, "invalid approval ratio, %q", thresholds[i])
+		}
+
+		if _, found := m[l[0]]; found {
+			return types.ApprovalThresholds{}, errors.Errorf("duplicated approval threshold calldata type, %q", thresholds[i])
+		}
+		m[l[0]] = types.PercentRatio(r)
+	}
+
+	at := types.NewApprovalThresholds(m)
+	if err := at.IsValid(nil); err != nil {
+		return types.ApprovalThresholds{}, err
+	}
+
+	return at, nil
+}
+
+func parseTallyCommittee(enc encoder.Encoder, members []string, publicKey string, threshold uint) (types.TallyCommittee, error) {
+	accounts := make([]base.Address, len(members))
+	verificationKeys := make([]string, len(members))
+	for i := range members {
+		l := strings.SplitN(members[i], ":", 2)
+		if len(l) != 2 {
+			return types.TallyCommittee{}, errors.Errorf("invalid tally committee member format, %q", members[i])
+		}
+
+		a, err := base.DecodeAddress(l[0], enc)
+		if err != nil {
+			return types.TallyCommittee{}, errors.Wrapf(err, "invalid tally committee account format, %q", members[i])
+		}
+		accounts[i] = a
+		verificationKeys[i] = l[1]
+	}
+
+	tc := types.NewTallyCommittee(accounts, verificationKeys, publicKey, threshold)
+	if err := tc.IsValid(nil); err != nil {
+		return types.TallyCommittee{}, err
+	}
+
+	return tc, nil
+}
+
+func parseFeeSponsorship(currency, accountCap, budget string) (types.FeeSponsorship, error) {
+	if budget == "" {
+		return types.NewFeeSponsorship("", common.ZeroBig, common.ZeroBig), nil
+	}
+
+	b, err := common.NewBigFromString(budget)
+	if err != nil {
+		return types.FeeSponsorship{}, errors.Wrapf(err, "invalid sponsor budget format, %q", budget)
+	}
+
+	ac, err := common.NewBigFromString(accountCap)
+	if err != nil {
+		return types.FeeSponsorship{}, errors.Wrapf(err, "invalid sponsor account cap format, %q", accountCap)
+	}
+
+	fs := types.NewFeeSponsorship(currencytypes.CurrencyID(currency), ac, b)
+	if err := fs.IsValid(nil); err != nil {
+		return types.FeeSponsorship{}, err
+	}
+
+	return fs, nil
+}
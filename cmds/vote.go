@@ -5,6 +5,7 @@ import (
 
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	"github.com/ProtoconNet/mitum-dao/operation/dao"
+	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/pkg/errors"
@@ -16,10 +17,13 @@ type VoteCommand struct {
 	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
 	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
 	ProposalID string                      `arg:"" name:"proposal-id" help:"proposal id" required:"true"`
-	Vote       uint8                       `arg:"" name:"vote" help:"vote" required:"true"`
+	Vote       string                      `arg:"" name:"vote" help:"vote option; for | against for crypto proposal, option number for biz and hybrid proposal, or abstain" required:"true"`
+	Options    uint8                       `name:"options" help:"number of vote options of proposal; abstain is the last option" default:"3"`
+	Ballot     []uint8                     `name:"ballot" help:"approved or ranked vote options; approval | ranked-choice proposal"`
 	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
 	sender     base.Address
 	contract   base.Address
+	vote       uint8
 }
 
 func (cmd *VoteCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -58,6 +62,12 @@ func (cmd *VoteCommand) parseFlags() error {
 	}
 	cmd.contract = contract
 
+	vote, err := types.ParseVoteOption(cmd.Vote, cmd.Options)
+	if err != nil {
+		return err
+	}
+	cmd.vote = vote
+
 	return nil
 }
 
@@ -69,7 +79,8 @@ func (cmd *VoteCommand) createOperation() (base.Operation, error) { // nolint:du
 		cmd.sender,
 		cmd.contract,
 		cmd.ProposalID,
-		cmd.Vote,
+		cmd.vote,
+		types.Ballot(cmd.Ballot),
 		cmd.Currency.CID,
 	)
 
@@ -41,6 +41,11 @@ type BlockSession struct {
 	daoDelegatorsModels     []mongo.WriteModel
 	daoVotersModels         []mongo.WriteModel
 	daoVotingPowerBoxModels []mongo.WriteModel
+	daoLockModels           []mongo.WriteModel
+	daoDelegationModels     []mongo.WriteModel
+	daoSponsorshipModels    []mongo.WriteModel
+	daoSponsoredFeesModels  []mongo.WriteModel
+	daoProposalResultModels []mongo.WriteModel
 	statesValue             *sync.Map
 	balanceAddressList      []string
 	buildinfo               string
@@ -174,6 +179,36 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 			}
 		}
 
+		if len(bs.daoLockModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDAOLock, bs.daoLockModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.daoDelegationModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDAODelegation, bs.daoDelegationModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.daoSponsorshipModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDAOSponsorship, bs.daoSponsorshipModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.daoSponsoredFeesModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDAOSponsoredFees, bs.daoSponsoredFeesModels); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(bs.daoProposalResultModels) > 0 {
+			if err := bs.writeModels(txnCtx, defaultColNameDAOProposalResult, bs.daoProposalResultModels); err != nil {
+				return nil, err
+			}
+		}
+
 		return nil, nil
 	})
 
@@ -1,7 +1,11 @@
 package digest
 
 import (
+	"strings"
+
+	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -16,6 +20,23 @@ func (bs *BlockSession) prepareDAO() error {
 	var daoDelegatorsModels []mongo.WriteModel
 	var daoVotersModels []mongo.WriteModel
 	var daoVotingPowerBoxModels []mongo.WriteModel
+	var daoLockModels []mongo.WriteModel
+	var daoDelegationModels []mongo.WriteModel
+	var daoSponsorshipModels []mongo.WriteModel
+	var daoSponsoredFeesModels []mongo.WriteModel
+	var daoProposalResultModels []mongo.WriteModel
+
+	// deposits are changed together with the proposal status
+	deposits := map[string]mitumbase.State{}
+	proposals := map[string]mitumbase.State{}
+	for i := range bs.sts {
+		switch st := bs.sts[i]; {
+		case state.IsStateDepositKey(st.Key()):
+			deposits[st.Key()] = st
+		case state.IsStateProposalKey(st.Key()):
+			proposals[st.Key()] = st
+		}
+	}
 
 	for i := range bs.sts {
 		st := bs.sts[i]
@@ -27,7 +48,7 @@ func (bs *BlockSession) prepareDAO() error {
 			}
 			daoDesignModels = append(daoDesignModels, j...)
 		case state.IsStateProposalKey(st.Key()):
-			j, err := bs.handleDAOProposalState(st)
+			j, err := bs.handleDAOProposalState(st, deposits)
 			if err != nil {
 				return err
 			}
@@ -45,11 +66,41 @@ func (bs *BlockSession) prepareDAO() error {
 			}
 			daoVotersModels = append(daoVotersModels, j...)
 		case state.IsStateVotingPowerBoxKey(st.Key()):
-			j, err := bs.handleDAOVotingPowerBoxState(st)
+			j, err := bs.handleDAOVotingPowerBoxState(st, proposals)
 			if err != nil {
 				return err
 			}
 			daoVotingPowerBoxModels = append(daoVotingPowerBoxModels, j...)
+		case state.IsStateLockKey(st.Key()):
+			j, err := bs.handleDAOLockState(st)
+			if err != nil {
+				return err
+			}
+			daoLockModels = append(daoLockModels, j...)
+		case state.IsStateDelegationKey(st.Key()):
+			j, err := bs.handleDAODelegationState(st)
+			if err != nil {
+				return err
+			}
+			daoDelegationModels = append(daoDelegationModels, j...)
+		case state.IsStateSponsorshipKey(st.Key()):
+			j, err := bs.handleDAOSponsorshipState(st)
+			if err != nil {
+				return err
+			}
+			daoSponsorshipModels = append(daoSponsorshipModels, j...)
+		case state.IsStateSponsoredFeesKey(st.Key()):
+			j, err := bs.handleDAOSponsoredFeesState(st)
+			if err != nil {
+				return err
+			}
+			daoSponsoredFeesModels = append(daoSponsoredFeesModels, j...)
+		case state.IsStateProposalResultKey(st.Key()):
+			j, err := bs.handleDAOProposalResultState(st)
+			if err != nil {
+				return err
+			}
+			daoProposalResultModels = append(daoProposalResultModels, j...)
 		default:
 			continue
 		}
@@ -60,6 +111,11 @@ func (bs *BlockSession) prepareDAO() error {
 	bs.daoDelegatorsModels = daoDelegatorsModels
 	bs.daoVotersModels = daoVotersModels
 	bs.daoVotingPowerBoxModels = daoVotingPowerBoxModels
+	bs.daoLockModels = daoLockModels
+	bs.daoDelegationModels = daoDelegationModels
+	bs.daoSponsorshipModels = daoSponsorshipModels
+	bs.daoSponsoredFeesModels = daoSponsoredFeesModels
+	bs.daoProposalResultModels = daoProposalResultModels
 
 	return nil
 }
@@ -74,8 +130,10 @@ func (bs *BlockSession) handleDAODesignState(st mitumbase.State) ([]mongo.WriteM
 	}
 }
 
-func (bs *BlockSession) handleDAOProposalState(st mitumbase.State) ([]mongo.WriteModel, error) {
-	if nftCollectionDoc, err := NewDAOProposalDoc(st, bs.st.DatabaseEncoder()); err != nil {
+func (bs *BlockSession) handleDAOProposalState(st mitumbase.State, deposits map[string]mitumbase.State) ([]mongo.WriteModel, error) {
+	dst := deposits[strings.TrimSuffix(st.Key(), state.ProposalSuffix)+state.DepositSuffix]
+
+	if nftCollectionDoc, err := NewDAOProposalDoc(st, dst, bs.st.DatabaseEncoder()); err != nil {
 		return nil, err
 	} else {
 		return []mongo.WriteModel{
@@ -104,8 +162,22 @@ func (bs *BlockSession) handleDAOVotersState(st mitumbase.State) ([]mongo.WriteM
 	}
 }
 
-func (bs *BlockSession) handleDAOVotingPowerBoxState(st mitumbase.State) ([]mongo.WriteModel, error) {
-	if nftLastIndexDoc, err := NewDAOVotingPowerBoxDoc(st, bs.st.DatabaseEncoder()); err != nil {
+func (bs *BlockSession) handleDAOVotingPowerBoxState(st mitumbase.State, proposals map[string]mitumbase.State) ([]mongo.WriteModel, error) {
+	// the proposal names the vote options of the voting power box
+	var proposal types.Proposal
+	if pst, found := proposals[strings.TrimSuffix(st.Key(), state.VotingPowerBoxSuffix)+state.ProposalSuffix]; found {
+		pv, err := state.StateProposalValue(pst)
+		if err != nil {
+			return nil, err
+		}
+		proposal = pv.Proposal()
+	} else if parsedKey, err := crcystate.ParseStateKey(st.Key(), state.DAOPrefix, 4); err == nil {
+		if pv, err := DAOProposal(bs.st, parsedKey[1], parsedKey[2]); err == nil {
+			proposal = pv.Proposal()
+		}
+	}
+
+	if nftLastIndexDoc, err := NewDAOVotingPowerBoxDoc(st, proposal, bs.st.DatabaseEncoder()); err != nil {
 		return nil, err
 	} else {
 		return []mongo.WriteModel{
@@ -113,3 +185,53 @@ func (bs *BlockSession) handleDAOVotingPowerBoxState(st mitumbase.State) ([]mong
 		}, nil
 	}
 }
+
+func (bs *BlockSession) handleDAOLockState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if lockDoc, err := NewDAOLockDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(lockDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleDAODelegationState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if delegationDoc, err := NewDAODelegationDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(delegationDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleDAOSponsorshipState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if sponsorshipDoc, err := NewDAOSponsorshipDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(sponsorshipDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleDAOSponsoredFeesState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if sponsoredFeesDoc, err := NewDAOSponsoredFeesDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(sponsoredFeesDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleDAOProposalResultState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if proposalResultDoc, err := NewDAOProposalResultDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(proposalResultDoc),
+		}, nil
+	}
+}
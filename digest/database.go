@@ -1,6 +1,7 @@
 package digest
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
 	"github.com/ProtoconNet/mitum-dao/state"
@@ -23,6 +24,11 @@ var (
 	defaultColNameDAODelegators     = "digest_dao_dac"
 	defaultColNameDAOVoters         = "digest_dao_vac"
 	defaultColNameDAOVotingPowerBox = "digest_dao_vpb"
+	defaultColNameDAOLock           = "digest_dao_lk"
+	defaultColNameDAODelegation     = "digest_dao_dlg"
+	defaultColNameDAOSponsorship    = "digest_dao_sp"
+	defaultColNameDAOSponsoredFees  = "digest_dao_spf"
+	defaultColNameDAOProposalResult = "digest_dao_prr"
 )
 
 func DAOService(st *currencydigest.Database, contract string) (*types.Design, error) {
@@ -198,3 +204,175 @@ func DAOVotingPowerBox(st *currencydigest.Database, contract, proposalID string)
 
 	return &votingPowerBox, nil
 }
+
+func DAOLock(st *currencydigest.Database, contract, account string) (*types.LockInfo, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("address", account)
+
+	var lock types.LockInfo
+	var sta mitumbase.State
+	var err error
+	if st.DatabaseClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.DatabaseClient().GetByFilter(
+		defaultColNameDAOLock,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			lock, err = state.StateLockValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &lock, nil
+}
+
+func DAODelegation(st *currencydigest.Database, contract, delegator string) (*types.DelegatorInfo, error) {
+	var (
+		delegations    []types.DelegatorInfo
+		sta            mitumbase.State
+		delegationInfo *types.DelegatorInfo
+		err            error
+	)
+
+	filter := util.NewBSONFilter("contract", contract)
+
+	if st.DatabaseClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.DatabaseClient().GetByFilter(
+		defaultColNameDAODelegation,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			delegations, err = state.StateDelegationValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	for i := range delegations {
+		if delegator == delegations[i].Account().String() {
+			delegationInfo = &delegations[i]
+			break
+		}
+	}
+	if delegationInfo == nil {
+		return nil, errors.Errorf("delegation not found, %s", delegator)
+	}
+
+	return delegationInfo, nil
+}
+
+func DAOSponsorship(st *currencydigest.Database, contract string) (*common.Big, error) {
+	filter := util.NewBSONFilter("contract", contract)
+
+	var spent common.Big
+	var sta mitumbase.State
+	var err error
+	if st.DatabaseClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.DatabaseClient().GetByFilter(
+		defaultColNameDAOSponsorship,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			spent, err = state.StateSponsorshipValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &spent, nil
+}
+
+func DAOSponsoredFees(st *currencydigest.Database, contract, proposalID string) ([]types.SponsoredFee, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("proposal_id", proposalID)
+
+	var fees []types.SponsoredFee
+	var sta mitumbase.State
+	var err error
+	if st.DatabaseClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.DatabaseClient().GetByFilter(
+		defaultColNameDAOSponsoredFees,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			fees, err = state.StateSponsoredFeesValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return fees, nil
+}
+
+func DAOProposalResult(st *currencydigest.Database, contract, proposalID string) (*types.ProposalResult, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("proposal_id", proposalID)
+
+	var result types.ProposalResult
+	var sta mitumbase.State
+	var err error
+	if st.DatabaseClient() == nil {
+		return nil, errors.Errorf("empty Database client")
+	} else if err = st.DatabaseClient().GetByFilter(
+		defaultColNameDAOProposalResult,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			result, err = state.StateProposalResultValue(sta)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -1,6 +1,7 @@
 package digest
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	mongodbstorage "github.com/ProtoconNet/mitum-currency/v3/digest/mongodb"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
@@ -8,6 +9,7 @@ import (
 	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util/encoder"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 type DAODesignDoc struct {
@@ -51,10 +53,15 @@ type DAOProposalDoc struct {
 	mongodbstorage.BaseDoc
 	st base.State
 	pr types.Proposal
+	po types.Policy
 	ps types.ProposalStatus
+	ve uint64
+	dp *types.Deposit
 }
 
-func NewDAOProposalDoc(st base.State, enc encoder.Encoder) (DAOProposalDoc, error) {
+// NewDAOProposalDoc creates the proposal document; dst is the deposit state
+// changed in the same block, nil if the deposit is not changed.
+func NewDAOProposalDoc(st base.State, dst base.State, enc encoder.Encoder) (DAOProposalDoc, error) {
 	pv, err := state.StateProposalValue(st)
 	if err != nil {
 		return DAOProposalDoc{}, err
@@ -64,11 +71,23 @@ func NewDAOProposalDoc(st base.State, enc encoder.Encoder) (DAOProposalDoc, erro
 		return DAOProposalDoc{}, err
 	}
 
+	var dp *types.Deposit
+	if dst != nil {
+		d, err := state.StateDepositValue(dst)
+		if err != nil {
+			return DAOProposalDoc{}, err
+		}
+		dp = &d
+	}
+
 	return DAOProposalDoc{
 		BaseDoc: b,
 		st:      st,
 		pr:      pv.Proposal(),
+		po:      pv.Policy(),
 		ps:      pv.Status(),
+		ve:      pv.VotingExtension(),
+		dp:      dp,
 	}, nil
 }
 
@@ -85,6 +104,27 @@ func (doc DAOProposalDoc) MarshalBSON() ([]byte, error) {
 	m["proposal"] = doc.pr
 	m["proposal_status"] = doc.ps
 
+	options := make([]string, doc.pr.VoteOptionsCount())
+	for i := range options {
+		options[i] = types.VoteOptionName(doc.pr, uint8(i))
+	}
+	m["vote_options"] = options
+
+	// period boundaries are in the period unit of the policy
+	periods := make([]bson.M, 0, len(types.LifecyclePeriods))
+	for _, period := range types.LifecyclePeriods {
+		start, end := types.GetPeriodBounds(doc.po, doc.pr, doc.ve, period)
+		periods = append(periods, bson.M{"period": period, "start": start, "end": end})
+	}
+	m["period_unit"] = doc.po.PeriodUnit()
+	m["voting_extension"] = doc.ve
+	m["periods"] = periods
+
+	if doc.dp != nil {
+		m["deposit"] = doc.dp
+		m["deposit_status"] = doc.dp.Status()
+	}
+
 	return bsonenc.Marshal(m)
 }
 
@@ -167,10 +207,13 @@ func (doc DAOVotersDoc) MarshalBSON() ([]byte, error) {
 type DAOVotingPowerBoxDoc struct {
 	mongodbstorage.BaseDoc
 	st  base.State
+	pr  types.Proposal
 	vpb types.VotingPowerBox
 }
 
-func NewDAOVotingPowerBoxDoc(st base.State, enc encoder.Encoder) (DAOVotingPowerBoxDoc, error) {
+// NewDAOVotingPowerBoxDoc creates the voting power box document; pr is the
+// proposal naming the vote options, nil if unknown.
+func NewDAOVotingPowerBoxDoc(st base.State, pr types.Proposal, enc encoder.Encoder) (DAOVotingPowerBoxDoc, error) {
 	vpb, err := state.StateVotingPowerBoxValue(st)
 	if err != nil {
 		return DAOVotingPowerBoxDoc{}, err
@@ -183,6 +226,7 @@ func NewDAOVotingPowerBoxDoc(st base.State, enc encoder.Encoder) (DAOVotingPower
 	return DAOVotingPowerBoxDoc{
 		BaseDoc: b,
 		st:      st,
+		pr:      pr,
 		vpb:     vpb,
 	}, nil
 }
@@ -199,5 +243,224 @@ func (doc DAOVotingPowerBoxDoc) MarshalBSON() ([]byte, error) {
 	m["height"] = doc.st.Height()
 	m["voting_power_box"] = doc.vpb
 
+	// committed votes stay hidden until revealed, only the voters are listed
+	unrevealed := bson.M{}
+	for k, w := range doc.vpb.Unrevealed() {
+		unrevealed[k] = w
+	}
+	m["unrevealed"] = unrevealed
+
+	if doc.pr != nil {
+		result := bson.M{}
+		for o, am := range doc.vpb.Result() {
+			result[types.VoteOptionName(doc.pr, o)] = am
+		}
+		m["vote_result"] = result
+
+		votes := bson.M{}
+		for k, vp := range doc.vpb.VotingPowers() {
+			if vp.Voted() && len(vp.Allocations()) < 1 && len(vp.Ballot()) < 1 {
+				votes[k] = types.VoteOptionName(doc.pr, vp.VoteFor())
+			}
+		}
+		m["votes"] = votes
+
+		// the encrypted tally lets the tally committee create decryption shares
+		if doc.vpb.EncryptedVoted().OverZero() {
+			if tally, err := doc.vpb.EncryptedTally(doc.pr.VoteOptionsCount()); err == nil {
+				m["encrypted_tally"] = tally
+			}
+		}
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOLockDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	lk types.LockInfo
+}
+
+func NewDAOLockDoc(st base.State, enc encoder.Encoder) (DAOLockDoc, error) {
+	lk, err := state.StateLockValue(st)
+	if err != nil {
+		return DAOLockDoc{}, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOLockDoc{}, err
+	}
+
+	return DAOLockDoc{
+		BaseDoc: b,
+		st:      st,
+		lk:      lk,
+	}, nil
+}
+
+func (doc DAOLockDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["address"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+	m["lock"] = doc.lk
+
+	return bsonenc.Marshal(m)
+}
+
+type DAODelegationDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	di []types.DelegatorInfo
+}
+
+func NewDAODelegationDoc(st base.State, enc encoder.Encoder) (DAODelegationDoc, error) {
+	di, err := state.StateDelegationValue(st)
+	if err != nil {
+		return DAODelegationDoc{}, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAODelegationDoc{}, err
+	}
+
+	return DAODelegationDoc{
+		BaseDoc: b,
+		st:      st,
+		di:      di,
+	}, nil
+}
+
+func (doc DAODelegationDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.DAOPrefix, 3)
+	m["contract"] = parsedKey[1]
+	m["height"] = doc.st.Height()
+	m["delegations"] = doc.di
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOSponsorshipDoc struct {
+	mongodbstorage.BaseDoc
+	st    base.State
+	spent common.Big
+}
+
+func NewDAOSponsorshipDoc(st base.State, enc encoder.Encoder) (DAOSponsorshipDoc, error) {
+	spent, err := state.StateSponsorshipValue(st)
+	if err != nil {
+		return DAOSponsorshipDoc{}, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOSponsorshipDoc{}, err
+	}
+
+	return DAOSponsorshipDoc{
+		BaseDoc: b,
+		st:      st,
+		spent:   spent,
+	}, nil
+}
+
+func (doc DAOSponsorshipDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.DAOPrefix, 3)
+	m["contract"] = parsedKey[1]
+	m["height"] = doc.st.Height()
+	m["spent"] = doc.spent.String()
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOSponsoredFeesDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	sf []types.SponsoredFee
+}
+
+func NewDAOSponsoredFeesDoc(st base.State, enc encoder.Encoder) (DAOSponsoredFeesDoc, error) {
+	sf, err := state.StateSponsoredFeesValue(st)
+	if err != nil {
+		return DAOSponsoredFeesDoc{}, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOSponsoredFeesDoc{}, err
+	}
+
+	return DAOSponsoredFeesDoc{
+		BaseDoc: b,
+		st:      st,
+		sf:      sf,
+	}, nil
+}
+
+func (doc DAOSponsoredFeesDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["proposal_id"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+	m["fees"] = doc.sf
+
+	return bsonenc.Marshal(m)
+}
+
+type DAOProposalResultDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	pr types.ProposalResult
+}
+
+func NewDAOProposalResultDoc(st base.State, enc encoder.Encoder) (DAOProposalResultDoc, error) {
+	pr, err := state.StateProposalResultValue(st)
+	if err != nil {
+		return DAOProposalResultDoc{}, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return DAOProposalResultDoc{}, err
+	}
+
+	return DAOProposalResultDoc{
+		BaseDoc: b,
+		st:      st,
+		pr:      pr,
+	}, nil
+}
+
+func (doc DAOProposalResultDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := crcystate.ParseStateKey(doc.st.Key(), state.DAOPrefix, 4)
+	m["contract"] = parsedKey[1]
+	m["proposal_id"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+	m["status"] = doc.pr.Status()
+	m["rule"] = doc.pr.Rule()
+
 	return bsonenc.Marshal(m)
 }
@@ -22,11 +22,17 @@ import (
 )
 
 var (
-	HandlerPathDAOService        = `/dao/{contract:\w+}/service`
-	HandlerPathDAOProposal       = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}`
-	HandlerPathDAODelegator      = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/delegator/{address:(?i)` + base.REStringAddressString + `}`
-	HandlerPathDAOVoters         = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/voter`
-	HandlerPathDAOVotingPowerBox = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/votingpower` // revive:disable-line:line-length-limit
+	HandlerPathDAOService          = `/dao/{contract:\w+}/service`
+	HandlerPathDAOProposal         = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}`
+	HandlerPathDAOProposalMetadata = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/metadata`
+	HandlerPathDAODelegator        = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/delegator/{address:(?i)` + base.REStringAddressString + `}`
+	HandlerPathDAOVoters           = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/voter`
+	HandlerPathDAOVotingPowerBox   = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/votingpower` // revive:disable-line:line-length-limit
+	HandlerPathDAOLock             = `/dao/{contract:\w+}/account/{address:(?i)` + base.REStringAddressString + `}/lock`
+	HandlerPathDAODelegation       = `/dao/{contract:\w+}/account/{address:(?i)` + base.REStringAddressString + `}/delegation`
+	HandlerPathDAOSponsorship      = `/dao/{contract:\w+}/sponsorship`
+	HandlerPathDAOSponsoredFees    = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/sponsoredfee`
+	HandlerPathDAOProposalResult   = `/dao/{contract:\w+}/proposal/{proposal_id:\w+}/result`
 )
 
 func init() {
@@ -121,12 +127,24 @@ func (hd *Handlers) setHandlers() {
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDAOProposal, hd.handleDAOProposal, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDAOProposalMetadata, hd.handleDAOProposalMetadata, true).
+		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDAODelegator, hd.handleDAODelegator, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDAOVoters, hd.handleDAOVoters, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDAOVotingPowerBox, hd.handleDAOVotingPowerBox, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDAOLock, hd.handleDAOLock, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDAODelegation, hd.handleDAODelegation, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDAOSponsorship, hd.handleDAOSponsorship, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDAOSponsoredFees, hd.handleDAOSponsoredFees, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDAOProposalResult, hd.handleDAOProposalResult, true).
+		Methods(http.MethodOptions, "GET")
 }
 
 func (hd *Handlers) setHandler(prefix string, h network.HTTPHandlerFunc, useCache bool) *mux.Route {
@@ -1,6 +1,7 @@
 package digest
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
 	"github.com/ProtoconNet/mitum-dao/state"
 	"github.com/ProtoconNet/mitum-dao/types"
@@ -121,6 +122,64 @@ func (hd *Handlers) buildDAOProposalHal(contract, proposalID string, proposal st
 	return hal, nil
 }
 
+func (hd *Handlers) handleDAOProposalMetadata(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	proposalID, err, status := parseRequest(w, r, "proposal_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDAOProposalMetadataInGroup(contract, proposalID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Millisecond*500)
+		}
+	}
+}
+
+func (hd *Handlers) handleDAOProposalMetadataInGroup(contract, proposalID string) (interface{}, error) {
+	switch proposal, err := DAOProposal(hd.database, contract, proposalID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "proposal metadata, contract %s, proposalID %s", contract, proposalID)
+	case proposal == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("proposal metadata, contract %s, proposalID %s", contract, proposalID)
+	default:
+		hal, err := hd.buildDAOProposalMetadataHal(contract, proposalID, proposal.Proposal().Metadata())
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildDAOProposalMetadataHal(
+	contract, proposalID string, metadata types.ProposalMetadata,
+) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathDAOProposalMetadata, "contract", contract, "proposal_id", proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(metadata, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
 func (hd *Handlers) handleDAODelegator(w http.ResponseWriter, r *http.Request) {
 	cacheKey := currencydigest.CacheKeyPath(r)
 	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
@@ -313,6 +372,284 @@ func (hd *Handlers) buildDAOVotingPowerBoxHal(
 	return hal, nil
 }
 
+func (hd *Handlers) handleDAOLock(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	account, err, status := parseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDAOLockInGroup(contract, account)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Millisecond*500)
+		}
+	}
+}
+
+func (hd *Handlers) handleDAOLockInGroup(contract, account string) (interface{}, error) {
+	switch lock, err := DAOLock(hd.database, contract, account); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "lock, contract %s, account %s", contract, account)
+	case lock == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("lock, contract %s, account %s", contract, account)
+	default:
+		hal, err := hd.buildDAOLockHal(contract, account, *lock)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildDAOLockHal(contract, account string, lock types.LockInfo) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathDAOLock, "contract", contract, "address", account)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(lock, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleDAODelegation(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	delegator, err, status := parseRequest(w, r, "address")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDAODelegationInGroup(contract, delegator)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Millisecond*500)
+		}
+	}
+}
+
+func (hd *Handlers) handleDAODelegationInGroup(contract, delegator string) (interface{}, error) {
+	switch delegation, err := DAODelegation(hd.database, contract, delegator); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "delegation, contract %s, delegator %s", contract, delegator)
+	case delegation == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("delegation, contract %s, delegator %s", contract, delegator)
+	default:
+		hal, err := hd.buildDAODelegationHal(contract, delegator, *delegation)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildDAODelegationHal(contract, delegator string, delegation types.DelegatorInfo) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathDAODelegation, "contract", contract, "address", delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(delegation, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleDAOSponsorship(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDAOSponsorshipInGroup(contract)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Millisecond*500)
+		}
+	}
+}
+
+func (hd *Handlers) handleDAOSponsorshipInGroup(contract string) (interface{}, error) {
+	switch spent, err := DAOSponsorship(hd.database, contract); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "sponsorship, contract %s", contract)
+	case spent == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("sponsorship, contract %s", contract)
+	default:
+		hal, err := hd.buildDAOSponsorshipHal(contract, *spent)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildDAOSponsorshipHal(contract string, spent common.Big) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathDAOSponsorship, "contract", contract)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(map[string]string{"spent": spent.String()}, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleDAOSponsoredFees(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	proposalID, err, status := parseRequest(w, r, "proposal_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDAOSponsoredFeesInGroup(contract, proposalID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Millisecond*500)
+		}
+	}
+}
+
+func (hd *Handlers) handleDAOSponsoredFeesInGroup(contract, proposalID string) (interface{}, error) {
+	switch fees, err := DAOSponsoredFees(hd.database, contract, proposalID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "sponsored fees, contract %s, proposalID %s", contract, proposalID)
+	case fees == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("sponsored fees, contract %s, proposalID %s", contract, proposalID)
+	default:
+		hal, err := hd.buildDAOSponsoredFeesHal(contract, proposalID, fees)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildDAOSponsoredFeesHal(
+	contract, proposalID string, fees []types.SponsoredFee,
+) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathDAOSponsoredFees, "contract", contract, "proposal_id", proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(fees, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
+func (hd *Handlers) handleDAOProposalResult(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	proposalID, err, status := parseRequest(w, r, "proposal_id")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDAOProposalResultInGroup(contract, proposalID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Millisecond*500)
+		}
+	}
+}
+
+func (hd *Handlers) handleDAOProposalResultInGroup(contract, proposalID string) (interface{}, error) {
+	switch result, err := DAOProposalResult(hd.database, contract, proposalID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "proposal result, contract %s, proposalID %s", contract, proposalID)
+	case result == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("proposal result, contract %s, proposalID %s", contract, proposalID)
+	default:
+		hal, err := hd.buildDAOProposalResultHal(contract, proposalID, *result)
+		if err != nil {
+			return nil, err
+		}
+		return hd.encoder.Marshal(hal)
+	}
+}
+
+func (hd *Handlers) buildDAOProposalResultHal(
+	contract, proposalID string, result types.ProposalResult,
+) (currencydigest.Hal, error) {
+	h, err := hd.combineURL(HandlerPathDAOProposalResult, "contract", contract, "proposal_id", proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	hal := currencydigest.NewBaseHal(result, currencydigest.NewHalLink(h, nil))
+
+	return hal, nil
+}
+
 func parseRequest(_ http.ResponseWriter, r *http.Request, v string) (string, error, int) {
 	s, found := mux.Vars(r)[v]
 	if !found {
@@ -0,0 +1,155 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ApproveFactHint = hint.MustNewHint("mitum-dao-approve-operation-fact-v0.0.1")
+	ApproveHint     = hint.MustNewHint("mitum-dao-approve-operation-v0.0.1")
+)
+
+// ApproveFact sets the allowance of sender's balance of allowanceCurrency
+// which transfer calldata of the dao proposals can spend.
+// Zero amount revokes the allowance. expiry is unix time in seconds, 0 means no expiry.
+type ApproveFact struct {
+	base.BaseFact
+	sender            base.Address
+	contract          base.Address
+	allowanceCurrency currencytypes.CurrencyID
+	amount            common.Big
+	expiry            uint64
+	currency          currencytypes.CurrencyID
+}
+
+func NewApproveFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	allowanceCurrency currencytypes.CurrencyID,
+	amount common.Big,
+	expiry uint64,
+	currency currencytypes.CurrencyID,
+) ApproveFact {
+	bf := base.NewBaseFact(ApproveFactHint, token)
+	fact := ApproveFact{
+		BaseFact:          bf,
+		sender:            sender,
+		contract:          contract,
+		allowanceCurrency: allowanceCurrency,
+		amount:            amount,
+		expiry:            expiry,
+		currency:          currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.allowanceCurrency.Bytes(),
+		fact.amount.Bytes(),
+		util.Uint64ToBytes(fact.expiry),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ApproveFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.allowanceCurrency,
+		fact.amount,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.amount.Compare(common.ZeroBig) < 0 {
+		return util.ErrInvalid.Errorf("allowance amount must not be under zero, %q", fact.amount)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact ApproveFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ApproveFact) AllowanceCurrency() currencytypes.CurrencyID {
+	return fact.allowanceCurrency
+}
+
+func (fact ApproveFact) Amount() common.Big {
+	return fact.amount
+}
+
+func (fact ApproveFact) Expiry() uint64 {
+	return fact.expiry
+}
+
+func (fact ApproveFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ApproveFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type Approve struct {
+	common.BaseOperation
+}
+
+func NewApprove(fact ApproveFact) (Approve, error) {
+	return Approve{BaseOperation: common.NewBaseOperation(ApproveHint, fact)}, nil
+}
+
+func (op *Approve) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
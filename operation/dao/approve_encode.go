@@ -0,0 +1,41 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *ApproveFact) unpack(enc encoder.Encoder,
+	sa, ca, acid, am string, ex uint64, cid string,
+) error {
+	e := util.StringError("failed to unmarshal ApproveFact")
+
+	fact.allowanceCurrency = currencytypes.CurrencyID(acid)
+	fact.expiry = ex
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	if big, err := common.NewBigFromString(am); err != nil {
+		return e.Wrap(err)
+	} else {
+		fact.amount = big
+	}
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
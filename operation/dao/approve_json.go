@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type ApproveFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner             base.Address             `json:"sender"`
+	Contract          base.Address             `json:"contract"`
+	AllowanceCurrency currencytypes.CurrencyID `json:"allowance_currency"`
+	Amount            common.Big               `json:"amount"`
+	Expiry            uint64                   `json:"expiry"`
+	Currency          currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact ApproveFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		AllowanceCurrency:     fact.allowanceCurrency,
+		Amount:                fact.amount,
+		Expiry:                fact.expiry,
+		Currency:              fact.currency,
+	})
+}
+
+type ApproveFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner             string `json:"sender"`
+	Contract          string `json:"contract"`
+	AllowanceCurrency string `json:"allowance_currency"`
+	Amount            string `json:"amount"`
+	Expiry            uint64 `json:"expiry"`
+	Currency          string `json:"currency"`
+}
+
+func (fact *ApproveFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveFact")
+
+	var uf ApproveFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.AllowanceCurrency,
+		uf.Amount,
+		uf.Expiry,
+		uf.Currency,
+	)
+}
+
+type ApproveMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Approve) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Approve) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Approve")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -151,9 +151,9 @@ func (opp *CancelProposalProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	}
 
-	period, start, _ := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Voting, blockMap)
+	period, start, _ := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.Voting, blockMap)
 	if !(period == types.PreLifeCycle || period == types.ProposalReview || period == types.Registration) {
-		return nil, base.NewBaseOperationProcessReasonError("cancellable period has passed; voting-started(%d), now(%d)", start, blockMap.Manifest().ProposedAt().Unix()), nil
+		return nil, base.NewBaseOperationProcessReasonError("cancellable period has passed; voting-started(%d), now(%d)", start, p.Policy().PeriodUnit().Now(blockMap)), nil
 	}
 
 	var sts []base.StateMergeValue
@@ -236,10 +236,40 @@ func (opp *CancelProposalProcessor) Process(
 		}
 	}
 
-	sts = append(sts, currencystate.NewStateMergeValue(
-		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-		state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy()),
-	))
+	totalSupply, actualTurnoutCount, err := turnoutThreshold(p, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	// canceled before the voting, nothing is counted
+	result := types.NewProposalResult(
+		types.Canceled,
+		totalSupply, common.ZeroBig, actualTurnoutCount,
+		common.ZeroBig, common.ZeroBig, common.ZeroBig,
+		map[uint8]common.Big{},
+		0,
+		false,
+		types.ApprovalRuleCancel,
+		opp.Height(),
+	)
+
+	sts = append(sts,
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+			state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy(), p.VotingExtension()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposalResult(fact.Contract(), fact.ProposalID()),
+			state.NewProposalResultStateValue(result),
+		),
+	)
+
+	dsts, err := settleDeposit(fact.Contract(), fact.ProposalID(), true, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to refund deposit, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	sts = append(sts, dsts...)
 
 	return sts, nil, nil
 }
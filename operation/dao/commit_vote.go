@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	CommitVoteFactHint = hint.MustNewHint("mitum-dao-commit-vote-operation-fact-v0.0.1")
+	CommitVoteHint     = hint.MustNewHint("mitum-dao-commit-vote-operation-v0.0.1")
+)
+
+type CommitVoteFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	commitment string
+	currency   currencytypes.CurrencyID
+}
+
+func NewCommitVoteFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	commitment string,
+	currency currencytypes.CurrencyID,
+) CommitVoteFact {
+	bf := base.NewBaseFact(CommitVoteFactHint, token)
+	fact := CommitVoteFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		commitment: commitment,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact CommitVoteFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact CommitVoteFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact CommitVoteFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		[]byte(fact.commitment),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact CommitVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if len(fact.commitment) == 0 {
+		return util.ErrInvalid.Errorf("empty commitment")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact CommitVoteFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact CommitVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact CommitVoteFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact CommitVoteFact) ProposalID() string {
+	return fact.proposalID
+}
+
+// Commitment returns the hash of the vote to be revealed, see
+// types.NewVoteCommitment.
+func (fact CommitVoteFact) Commitment() string {
+	return fact.commitment
+}
+
+func (fact CommitVoteFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact CommitVoteFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type CommitVote struct {
+	common.BaseOperation
+}
+
+func NewCommitVote(fact CommitVoteFact) (CommitVote, error) {
+	return CommitVote{BaseOperation: common.NewBaseOperation(CommitVoteHint, fact)}, nil
+}
+
+func (op *CommitVote) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
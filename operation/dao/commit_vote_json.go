@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type CommitVoteFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Commitment string                   `json:"commitment"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact CommitVoteFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CommitVoteFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Commitment:            fact.commitment,
+		Currency:              fact.currency,
+	})
+}
+
+type CommitVoteFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	ProposalID string `json:"proposal_id"`
+	Commitment string `json:"commitment"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *CommitVoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of CommitVoteFact")
+
+	var uf CommitVoteFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Commitment,
+		uf.Currency,
+	)
+}
+
+type CommitVoteMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op CommitVote) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CommitVoteMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *CommitVote) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of CommitVote")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
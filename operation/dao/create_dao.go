@@ -32,6 +32,20 @@ type CreateDAOFact struct {
 	executionDelayPeriod uint64
 	turnout              types.PercentRatio
 	quorum               types.PercentRatio
+	allowVoteChange      bool
+	votingWeightMode     types.VotingWeightMode
+	escrowDeposit        bool
+	guardians            types.GuardianSet
+	periodUnit           types.PeriodUnit
+	approvalThresholds   types.ApprovalThresholds
+	abstainMode          types.AbstainMode
+	approvalBase         types.ApprovalBase
+	antiSnipingWindow    uint64
+	antiSnipingExtension uint64
+	revealPeriod         uint64
+	tallyCommittee       types.TallyCommittee
+	relayFeeReimbursed   bool
+	feeSponsorship       types.FeeSponsorship
 	currency             currencytypes.CurrencyID
 }
 
@@ -51,6 +65,20 @@ func NewCreateDAOFact(
 	postSnapshotPeriod,
 	executionDelayPeriod uint64,
 	turnout, quorum types.PercentRatio,
+	allowVoteChange bool,
+	votingWeightMode types.VotingWeightMode,
+	escrowDeposit bool,
+	guardians types.GuardianSet,
+	periodUnit types.PeriodUnit,
+	approvalThresholds types.ApprovalThresholds,
+	abstainMode types.AbstainMode,
+	approvalBase types.ApprovalBase,
+	antiSnipingWindow uint64,
+	antiSnipingExtension uint64,
+	revealPeriod uint64,
+	tallyCommittee types.TallyCommittee,
+	relayFeeReimbursed bool,
+	feeSponsorship types.FeeSponsorship,
 	currency currencytypes.CurrencyID,
 ) CreateDAOFact {
 	bf := base.NewBaseFact(CreateDAOFactHint, token)
@@ -71,6 +99,20 @@ func NewCreateDAOFact(
 		postSnapshotPeriod:   postSnapshotPeriod,
 		turnout:              turnout,
 		quorum:               quorum,
+		allowVoteChange:      allowVoteChange,
+		votingWeightMode:     votingWeightMode,
+		escrowDeposit:        escrowDeposit,
+		guardians:            guardians,
+		periodUnit:           periodUnit,
+		approvalThresholds:   approvalThresholds,
+		abstainMode:          abstainMode,
+		approvalBase:         approvalBase,
+		antiSnipingWindow:    antiSnipingWindow,
+		antiSnipingExtension: antiSnipingExtension,
+		revealPeriod:         revealPeriod,
+		tallyCommittee:       tallyCommittee,
+		relayFeeReimbursed:   relayFeeReimbursed,
+		feeSponsorship:       feeSponsorship,
 		currency:             currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -104,10 +146,33 @@ func (fact CreateDAOFact) Bytes() []byte {
 		util.Uint64ToBytes(fact.executionDelayPeriod),
 		fact.turnout.Bytes(),
 		fact.quorum.Bytes(),
+		fact.policy().ExtensionBytes(),
 		fact.currency.Bytes(),
 	)
 }
 
+func (fact CreateDAOFact) policy() types.Policy {
+	return types.NewPolicy(
+		fact.votingPowerToken, fact.threshold, fact.fee, fact.whitelist,
+		fact.proposalReviewPeriod, fact.registrationPeriod, fact.preSnapshotPeriod, fact.votingPeriod,
+		fact.postSnapshotPeriod, fact.executionDelayPeriod, fact.turnout, fact.quorum,
+		fact.allowVoteChange,
+		fact.votingWeightMode,
+		fact.escrowDeposit,
+		fact.guardians,
+		fact.periodUnit,
+		fact.approvalThresholds,
+		fact.abstainMode,
+		fact.approvalBase,
+		fact.antiSnipingWindow,
+		fact.antiSnipingExtension,
+		fact.revealPeriod,
+		fact.tallyCommittee,
+		fact.relayFeeReimbursed,
+		fact.feeSponsorship,
+	)
+}
+
 func (fact CreateDAOFact) IsValid(b []byte) error {
 	if err := fact.BaseHinter.IsValid(nil); err != nil {
 		return err
@@ -122,6 +187,14 @@ func (fact CreateDAOFact) IsValid(b []byte) error {
 		fact.whitelist,
 		fact.turnout,
 		fact.quorum,
+		fact.votingWeightMode,
+		fact.guardians,
+		fact.periodUnit,
+		fact.approvalThresholds,
+		fact.tallyCommittee,
+		fact.feeSponsorship,
+		fact.abstainMode,
+		fact.approvalBase,
 		fact.currency,
 	); err != nil {
 		return err
@@ -202,6 +275,62 @@ func (fact CreateDAOFact) Quorum() types.PercentRatio {
 	return fact.quorum
 }
 
+func (fact CreateDAOFact) AllowVoteChange() bool {
+	return fact.allowVoteChange
+}
+
+func (fact CreateDAOFact) VotingWeightMode() types.VotingWeightMode {
+	return fact.votingWeightMode
+}
+
+func (fact CreateDAOFact) EscrowDeposit() bool {
+	return fact.escrowDeposit
+}
+
+func (fact CreateDAOFact) Guardians() types.GuardianSet {
+	return fact.guardians
+}
+
+func (fact CreateDAOFact) PeriodUnit() types.PeriodUnit {
+	return fact.periodUnit
+}
+
+func (fact CreateDAOFact) ApprovalThresholds() types.ApprovalThresholds {
+	return fact.approvalThresholds
+}
+
+func (fact CreateDAOFact) AbstainMode() types.AbstainMode {
+	return fact.abstainMode
+}
+
+func (fact CreateDAOFact) ApprovalBase() types.ApprovalBase {
+	return fact.approvalBase
+}
+
+func (fact CreateDAOFact) AntiSnipingWindow() uint64 {
+	return fact.antiSnipingWindow
+}
+
+func (fact CreateDAOFact) AntiSnipingExtension() uint64 {
+	return fact.antiSnipingExtension
+}
+
+func (fact CreateDAOFact) RevealPeriod() uint64 {
+	return fact.revealPeriod
+}
+
+func (fact CreateDAOFact) TallyCommittee() types.TallyCommittee {
+	return fact.tallyCommittee
+}
+
+func (fact CreateDAOFact) RelayFeeReimbursed() bool {
+	return fact.relayFeeReimbursed
+}
+
+func (fact CreateDAOFact) FeeSponsorship() types.FeeSponsorship {
+	return fact.feeSponsorship
+}
+
 func (fact CreateDAOFact) Currency() currencytypes.CurrencyID {
 	return fact.currency
 }
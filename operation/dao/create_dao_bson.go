@@ -29,6 +29,20 @@ func (fact CreateDAOFact) MarshalBSON() ([]byte, error) {
 			"execution_delay_period": fact.executionDelayPeriod,
 			"turnout":                fact.turnout,
 			"quorum":                 fact.quorum,
+			"allow_vote_change":      fact.allowVoteChange,
+			"voting_weight_mode":     fact.votingWeightMode,
+			"escrow_deposit":         fact.escrowDeposit,
+			"guardians":              fact.guardians,
+			"period_unit":            fact.periodUnit,
+			"approval_thresholds":    fact.approvalThresholds,
+			"abstain_mode":           fact.abstainMode,
+			"approval_base":          fact.approvalBase,
+			"anti_sniping_window":    fact.antiSnipingWindow,
+			"anti_sniping_extension": fact.antiSnipingExtension,
+			"reveal_period":          fact.revealPeriod,
+			"tally_committee":        fact.tallyCommittee,
+			"relay_fee_reimbursed":   fact.relayFeeReimbursed,
+			"fee_sponsorship":        fact.feeSponsorship,
 			"currency":               fact.currency,
 			"hash":                   fact.BaseFact.Hash().String(),
 			"token":                  fact.BaseFact.Token(),
@@ -53,6 +67,20 @@ type CreateDAOFactBSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64   `bson:"execution_delay_period"`
 	Turnout              uint     `bson:"turnout"`
 	Quorum               uint     `bson:"quorum"`
+	AllowVoteChange      bool     `bson:"allow_vote_change"`
+	VotingWeightMode     string   `bson:"voting_weight_mode"`
+	EscrowDeposit        bool     `bson:"escrow_deposit"`
+	Guardians            bson.Raw `bson:"guardians"`
+	PeriodUnit           string   `bson:"period_unit"`
+	ApprovalThresholds   bson.Raw `bson:"approval_thresholds"`
+	AbstainMode          string   `bson:"abstain_mode"`
+	ApprovalBase         string   `bson:"approval_base"`
+	AntiSnipingWindow    uint64   `bson:"anti_sniping_window"`
+	AntiSnipingExtension uint64   `bson:"anti_sniping_extension"`
+	RevealPeriod         uint64   `bson:"reveal_period"`
+	TallyCommittee       bson.Raw `bson:"tally_committee"`
+	RelayFeeReimbursed   bool     `bson:"relay_fee_reimbursed"`
+	FeeSponsorship       bson.Raw `bson:"fee_sponsorship"`
 	Currency             string   `bson:"currency"`
 }
 
@@ -95,6 +123,20 @@ func (fact *CreateDAOFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uf.ExecutionDelayPeriod,
 		uf.Turnout,
 		uf.Quorum,
+		uf.AllowVoteChange,
+		uf.VotingWeightMode,
+		uf.EscrowDeposit,
+		uf.Guardians,
+		uf.PeriodUnit,
+		uf.ApprovalThresholds,
+		uf.AbstainMode,
+		uf.ApprovalBase,
+		uf.AntiSnipingWindow,
+		uf.AntiSnipingExtension,
+		uf.RevealPeriod,
+		uf.TallyCommittee,
+		uf.RelayFeeReimbursed,
+		uf.FeeSponsorship,
 		uf.Currency,
 	)
 }
@@ -28,6 +28,20 @@ type CreateDAOFactJSONMarshaler struct {
 	ExecutionDelayPeriod uint64                   `json:"execution_delay_period"`
 	Turnout              types.PercentRatio       `json:"turnout"`
 	Quorum               types.PercentRatio       `json:"quorum"`
+	AllowVoteChange      bool                     `json:"allow_vote_change"`
+	VotingWeightMode     types.VotingWeightMode   `json:"voting_weight_mode"`
+	EscrowDeposit        bool                     `json:"escrow_deposit"`
+	Guardians            types.GuardianSet        `json:"guardians"`
+	PeriodUnit           types.PeriodUnit         `json:"period_unit"`
+	ApprovalThresholds   types.ApprovalThresholds `json:"approval_thresholds"`
+	AbstainMode          types.AbstainMode        `json:"abstain_mode"`
+	ApprovalBase         types.ApprovalBase       `json:"approval_base"`
+	AntiSnipingWindow    uint64                   `json:"anti_sniping_window"`
+	AntiSnipingExtension uint64                   `json:"anti_sniping_extension"`
+	RevealPeriod         uint64                   `json:"reveal_period"`
+	TallyCommittee       types.TallyCommittee     `json:"tally_committee"`
+	RelayFeeReimbursed   bool                     `json:"relay_fee_reimbursed"`
+	FeeSponsorship       types.FeeSponsorship     `json:"fee_sponsorship"`
 	Currency             currencytypes.CurrencyID `json:"currency"`
 }
 
@@ -49,6 +63,20 @@ func (fact CreateDAOFact) MarshalJSON() ([]byte, error) {
 		ExecutionDelayPeriod:  fact.executionDelayPeriod,
 		Turnout:               fact.turnout,
 		Quorum:                fact.quorum,
+		AllowVoteChange:       fact.allowVoteChange,
+		VotingWeightMode:      fact.votingWeightMode,
+		EscrowDeposit:         fact.escrowDeposit,
+		Guardians:             fact.guardians,
+		PeriodUnit:            fact.periodUnit,
+		ApprovalThresholds:    fact.approvalThresholds,
+		AbstainMode:           fact.abstainMode,
+		ApprovalBase:          fact.approvalBase,
+		AntiSnipingWindow:     fact.antiSnipingWindow,
+		AntiSnipingExtension:  fact.antiSnipingExtension,
+		RevealPeriod:          fact.revealPeriod,
+		TallyCommittee:        fact.tallyCommittee,
+		RelayFeeReimbursed:    fact.relayFeeReimbursed,
+		FeeSponsorship:        fact.feeSponsorship,
 		Currency:              fact.currency,
 	})
 }
@@ -70,6 +98,20 @@ type CreateDAOFactJSONUnMarshaler struct {
 	ExecutionDelayPeriod uint64          `json:"execution_delay_period"`
 	Turnout              uint            `json:"turnout"`
 	Quorum               uint            `json:"quorum"`
+	AllowVoteChange      bool            `json:"allow_vote_change"`
+	VotingWeightMode     string          `json:"voting_weight_mode"`
+	EscrowDeposit        bool            `json:"escrow_deposit"`
+	Guardians            json.RawMessage `json:"guardians"`
+	PeriodUnit           string          `json:"period_unit"`
+	ApprovalThresholds   json.RawMessage `json:"approval_thresholds"`
+	AbstainMode          string          `json:"abstain_mode"`
+	ApprovalBase         string          `json:"approval_base"`
+	AntiSnipingWindow    uint64          `json:"anti_sniping_window"`
+	AntiSnipingExtension uint64          `json:"anti_sniping_extension"`
+	RevealPeriod         uint64          `json:"reveal_period"`
+	TallyCommittee       json.RawMessage `json:"tally_committee"`
+	RelayFeeReimbursed   bool            `json:"relay_fee_reimbursed"`
+	FeeSponsorship       json.RawMessage `json:"fee_sponsorship"`
 	Currency             string          `json:"currency"`
 }
 
@@ -99,6 +141,20 @@ func (fact *CreateDAOFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		uf.ExecutionDelayPeriod,
 		uf.Turnout,
 		uf.Quorum,
+		uf.AllowVoteChange,
+		uf.VotingWeightMode,
+		uf.EscrowDeposit,
+		uf.Guardians,
+		uf.PeriodUnit,
+		uf.ApprovalThresholds,
+		uf.AbstainMode,
+		uf.ApprovalBase,
+		uf.AntiSnipingWindow,
+		uf.AntiSnipingExtension,
+		uf.RevealPeriod,
+		uf.TallyCommittee,
+		uf.RelayFeeReimbursed,
+		uf.FeeSponsorship,
 		uf.Currency,
 	)
 }
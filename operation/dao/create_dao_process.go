@@ -112,6 +112,12 @@ func (opp *CreateDAOProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("voting power token design not found, %q: %w", fact.VotingPowerToken(), err), nil
 	}
 
+	if fs := fact.FeeSponsorship(); fs.Active() {
+		if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fs.Currency()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("fee sponsorship currency design not found, %q: %w", fs.Currency(), err), nil
+		}
+	}
+
 	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
 	}
@@ -130,11 +136,7 @@ func (opp *CreateDAOProcessor) Process(
 		return nil, nil, e.Errorf("expected CreateDAOFact, not %T", op.Fact())
 	}
 
-	policy := types.NewPolicy(
-		fact.votingPowerToken, fact.threshold, fact.fee, fact.whitelist,
-		fact.proposalReviewPeriod, fact.registrationPeriod, fact.preSnapshotPeriod, fact.votingPeriod,
-		fact.postSnapshotPeriod, fact.executionDelayPeriod, fact.turnout, fact.quorum,
-	)
+	policy := fact.policy()
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid dao policy, %s: %w", fact.Contract(), err), nil
 	}
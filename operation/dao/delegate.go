@@ -0,0 +1,136 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	DelegateFactHint = hint.MustNewHint("mitum-dao-delegate-operation-fact-v0.0.1")
+	DelegateHint     = hint.MustNewHint("mitum-dao-delegate-operation-v0.0.1")
+)
+
+type DelegateFact struct {
+	base.BaseFact
+	sender    base.Address
+	contract  base.Address
+	delegatee base.Address
+	currency  currencytypes.CurrencyID
+}
+
+func NewDelegateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	delegatee base.Address,
+	currency currencytypes.CurrencyID,
+) DelegateFact {
+	bf := base.NewBaseFact(DelegateFactHint, token)
+	fact := DelegateFact{
+		BaseFact:  bf,
+		sender:    sender,
+		contract:  contract,
+		delegatee: delegatee,
+		currency:  currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact DelegateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact DelegateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact DelegateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.delegatee.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact DelegateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.delegatee,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.delegatee.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with delegatee, %q", fact.delegatee)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact DelegateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact DelegateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact DelegateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact DelegateFact) Delegatee() base.Address {
+	return fact.delegatee
+}
+
+func (fact DelegateFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact DelegateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.delegatee
+
+	return as, nil
+}
+
+type Delegate struct {
+	common.BaseOperation
+}
+
+func NewDelegate(fact DelegateFact) (Delegate, error) {
+	return Delegate{BaseOperation: common.NewBaseOperation(DelegateHint, fact)}, nil
+}
+
+func (op *Delegate) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package dao
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *DelegateFact) unpack(enc encoder.Encoder,
+	sa, ca, da, cid string,
+) error {
+	e := util.StringError("failed to unmarshal DelegateFact")
+
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(da, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.delegatee = a
+	}
+
+	return nil
+}
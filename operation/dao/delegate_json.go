@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type DelegateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner     base.Address             `json:"sender"`
+	Contract  base.Address             `json:"contract"`
+	Delegatee base.Address             `json:"delegatee"`
+	Currency  currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact DelegateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DelegateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Delegatee:             fact.delegatee,
+		Currency:              fact.currency,
+	})
+}
+
+type DelegateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner     string `json:"sender"`
+	Contract  string `json:"contract"`
+	Delegatee string `json:"delegatee"`
+	Currency  string `json:"currency"`
+}
+
+func (fact *DelegateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DelegateFact")
+
+	var uf DelegateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Delegatee,
+		uf.Currency,
+	)
+}
+
+type DelegateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Delegate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DelegateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Delegate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Delegate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
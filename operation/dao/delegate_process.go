@@ -0,0 +1,243 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var delegateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(DelegateProcessor)
+	},
+}
+
+func (Delegate) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type DelegateProcessor struct {
+	*base.BaseOperationProcessor
+	getLastBlockFunc processor.GetLastBlockFunc
+}
+
+func NewDelegateProcessor(getLastBlockFunc processor.GetLastBlockFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new DelegateProcessor")
+
+		nopp := delegateProcessorPool.Get()
+		opp, ok := nopp.(*DelegateProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected DelegateProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getLastBlockFunc = getLastBlockFunc
+
+		return opp, nil
+	}
+}
+
+func (opp *DelegateProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess Delegate")
+
+	fact, ok := op.Fact().(DelegateFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not DelegateFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender cannot be a contract account, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao contract account not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Delegatee()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("delegatee not found, %s: %w", fact.Delegatee(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Delegatee()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("delegatee cannot be a contract account, %s: %w", fact.Delegatee(), err), nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyDelegation(fact.Contract())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to find delegation state, %s: %w", fact.Contract(), err), nil
+	case found:
+		delegations, err := state.StateDelegationValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find delegation value from state, %s: %w", fact.Contract(), err), nil
+		}
+
+		for _, delegation := range delegations {
+			if delegation.Account().Equal(fact.Sender()) && delegation.Delegatee().Equal(fact.Delegatee()) {
+				return nil, base.NewBaseOperationProcessReasonError(
+					"sender already delegates the account, %s delegated by %s, %s",
+					fact.Delegatee(),
+					fact.Sender(),
+					fact.Contract(),
+				), nil
+			}
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *DelegateProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Delegate")
+
+	fact, ok := op.Fact().(DelegateFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected DelegateFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue
+
+	{ // caculate operation fee
+		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q; %w", fact.Currency(), err), nil
+		}
+
+		fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to check fee of currency, %q; %w",
+				fact.Currency(),
+				err,
+			), nil
+		}
+
+		senderBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+			"key of sender balance",
+			getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender balance not found, %q; %w",
+				fact.Sender(),
+				err,
+			), nil
+		}
+
+		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get balance value, %q; %w",
+				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				err,
+			), nil
+		case senderBal.Big().Compare(fee) < 0:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"not enough balance of sender, %q",
+				fact.Sender(),
+			), nil
+		}
+
+		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+		}
+
+		if currencyPolicy.Feeer().Receiver() != nil {
+			if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+				return nil, nil, err
+			} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+				return nil, nil, err
+			} else if !found {
+				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
+				if !ok {
+					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
+				}
+				sts = append(sts, common.NewBaseStateMergeValue(
+					feeRcvrSt.Key(),
+					currency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+					},
+				))
+
+				sts = append(sts, common.NewBaseStateMergeValue(
+					senderBalSt.Key(),
+					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+					},
+				))
+			}
+		}
+	}
+
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			state.StateKeyDelegation(fact.Contract()),
+			state.NewDelegationStateValue([]types.DelegatorInfo{types.NewDelegatorInfo(fact.Sender(), fact.Delegatee())}),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewDelegationStateValueMerger(height, state.StateKeyDelegation(fact.Contract()), st)
+			},
+		),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *DelegateProcessor) Close() error {
+	delegateProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// settleDeposit releases the deposit escrowed for the proposal. With refund,
+// the deposit is returned to the proposer, otherwise it is slashed into the dao
+// contract treasury. Proposals without escrowed deposit return nothing.
+func settleDeposit(
+	contract base.Address, proposalID string, refund bool, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var deposit types.Deposit
+	switch st, found, err := getStateFunc(state.StateKeyDeposit(contract, proposalID)); {
+	case err != nil:
+		return nil, errors.Errorf("failed to find deposit state, %s, %q: %v", contract, proposalID, err)
+	case !found:
+		return nil, nil
+	default:
+		d, err := state.StateDepositValue(st)
+		if err != nil {
+			return nil, errors.Errorf("failed to find deposit value from state, %s, %q: %v", contract, proposalID, err)
+		}
+		deposit = d
+	}
+
+	if deposit.Status() != types.DepositEscrowed {
+		return nil, nil
+	}
+
+	// the escrowed deposit is held by the deposit state, not by the contract
+	// balance, so the treasury can not spend it until it is slashed
+	receiver := deposit.Proposer()
+	deposit.SetStatus(types.DepositRefunded)
+
+	if !refund {
+		receiver = contract
+		deposit.SetStatus(types.DepositSlashed)
+	}
+
+	cid := deposit.Currency()
+
+	return []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			currency.StateKeyBalance(receiver, cid),
+			currency.NewAddBalanceStateValue(currencytypes.NewAmount(deposit.Amount(), cid)),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, currency.StateKeyBalance(receiver, cid), cid, st)
+			},
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyDeposit(contract, proposalID),
+			state.NewDepositStateValue(deposit),
+		),
+	}, nil
+}
@@ -2,9 +2,12 @@ package dao
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencyoperation "github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/operation/extension"
 	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
 	crcystate "github.com/ProtoconNet/mitum-currency/v3/state"
 	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
@@ -29,12 +32,21 @@ func (Execute) Process(
 	return nil, nil, nil
 }
 
+// NewOperationProcessorFunc creates the processor for the operations embedded
+// in OperationCallData.
+type NewOperationProcessorFunc func(base.Height, base.GetStateFunc) (base.OperationProcessor, error)
+
 type ExecuteProcessor struct {
 	*base.BaseOperationProcessor
-	getLastBlockFunc processor.GetLastBlockFunc
+	height                    base.Height
+	getLastBlockFunc          processor.GetLastBlockFunc
+	newOperationProcessorFunc NewOperationProcessorFunc
 }
 
-func NewExecuteProcessor(getLastBlockFunc processor.GetLastBlockFunc) crcytypes.GetNewProcessor {
+func NewExecuteProcessor(
+	getLastBlockFunc processor.GetLastBlockFunc,
+	newOperationProcessorFunc NewOperationProcessorFunc,
+) crcytypes.GetNewProcessor {
 	return func(
 		height base.Height,
 		getStateFunc base.GetStateFunc,
@@ -56,7 +68,9 @@ func NewExecuteProcessor(getLastBlockFunc processor.GetLastBlockFunc) crcytypes.
 		}
 
 		opp.BaseOperationProcessor = b
+		opp.height = height
 		opp.getLastBlockFunc = getLastBlockFunc
+		opp.newOperationProcessorFunc = newOperationProcessorFunc
 
 		return opp, nil
 	}
@@ -126,6 +140,8 @@ func (opp *ExecuteProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("rejected proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
 	} else if p.Status() == types.Executed {
 		return nil, base.NewBaseOperationProcessReasonError("already executed, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	} else if p.Status() == types.Vetoed {
+		return nil, base.NewBaseOperationProcessReasonError("vetoed proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
 	}
 
 	if err := crcystate.CheckExistsState(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
@@ -140,7 +156,7 @@ func (opp *ExecuteProcessor) PreProcess(
 }
 
 func (opp *ExecuteProcessor) Process(
-	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
 	[]base.StateMergeValue, base.OperationProcessReasonError, error,
 ) {
 	e := util.StringError("failed to process Execute")
@@ -171,9 +187,16 @@ func (opp *ExecuteProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	}
 
-	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Execute, blockMap)
-	if period != types.Execute {
-		return nil, base.NewBaseOperationProcessReasonError("current time is not within the Execution, Execution period; start(%d), end(%d), but now(%d)", start, end, blockMap.Manifest().ProposedAt().Unix()), nil
+	// the first execute after the voting period takes the post snapshot of the
+	// pre-snapped proposal; the proposal is executed by the next execute over
+	// the settled deposit. A proposal tallied after the PostSnapshot period
+	// gets the whole execution delay from the tally, so the guardians can veto
+	// it before it is executed.
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.Execute, blockMap)
+	tally := p.Status() == types.PreSnapped &&
+		(period == types.PostSnapshot || period == types.ExecutionDelay || period == types.Execute)
+	if period != types.Execute && !tally {
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within the Execution, Execution period; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
 	}
 
 	var sts []base.StateMergeValue
@@ -193,36 +216,44 @@ func (opp *ExecuteProcessor) Process(
 			), nil
 		}
 
-		senderBalSt, err := crcystate.ExistsState(
-			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
-			"key of sender balance",
+		payer, sponsored, err := feePayer(
+			fact.Contract(), fact.ProposalID(), fact.Sender(), fact.Currency(), fee, currencyPolicy.Feeer().Receiver(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to check fee sponsorship; %w", err), nil
+		}
+		sts = append(sts, sponsored...)
+
+		payerBalSt, err := crcystate.ExistsState(
+			currency.StateKeyBalance(payer, fact.Currency()),
+			"key of payer balance",
 			getStateFunc,
 		)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
-				"sender balance not found, %q; %w",
-				fact.Sender(),
+				"payer balance not found, %q; %w",
+				payer,
 				err,
 			), nil
 		}
 
-		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		switch payerBal, err := currency.StateBalanceValue(payerBalSt); {
 		case err != nil:
 			return nil, base.NewBaseOperationProcessReasonError(
 				"failed to get balance value, %q; %w",
-				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				currency.StateKeyBalance(payer, fact.Currency()),
 				err,
 			), nil
-		case senderBal.Big().Compare(fee) < 0:
+		case payerBal.Big().Compare(fee) < 0:
 			return nil, base.NewBaseOperationProcessReasonError(
-				"not enough balance of sender, %q",
-				fact.Sender(),
+				"not enough balance of payer, %q",
+				payer,
 			), nil
 		}
 
-		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		v, ok := payerBalSt.Value().(currency.BalanceStateValue)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", payerBalSt.Value()), nil
 		}
 
 		if currencyPolicy.Feeer().Receiver() != nil {
@@ -232,7 +263,7 @@ func (opp *ExecuteProcessor) Process(
 				return nil, nil, err
 			} else if !found {
 				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
-			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+			} else if feeRcvrSt.Key() != payerBalSt.Key() {
 				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
 				if !ok {
 					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
@@ -246,128 +277,427 @@ func (opp *ExecuteProcessor) Process(
 				))
 
 				sts = append(sts, common.NewBaseStateMergeValue(
-					senderBalSt.Key(),
+					payerBalSt.Key(),
 					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
 					func(height base.Height, st base.State) base.StateValueMerger {
-						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+						return currency.NewBalanceStateValueMerger(height, payerBalSt.Key(), fact.currency, st)
 					},
 				))
 			}
 		}
 	}
 
+	if tally {
+		r, tsts, err := tallyProposal(fact.Contract(), fact.ProposalID(), p, opp.height, getStateFunc)
+		switch {
+		case errors.Is(err, errTallyNotDecrypted) && period == types.Execute:
+			// not decrypted until the decryption deadline
+			csts, err := cancelForDecryption(fact.Contract(), fact.ProposalID(), p, opp.height, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to cancel proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			}
+
+			return append(sts, csts...), nil, nil
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to tally proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		sts = append(sts, tsts...)
+
+		votingExtension := p.VotingExtension()
+		if period != types.PostSnapshot {
+			delayStart, _ := types.GetPeriodBounds(p.Policy(), p.Proposal(), votingExtension, types.ExecutionDelay)
+			votingExtension += p.Policy().PeriodUnit().Now(blockMap) - uint64(delayStart)
+		}
+
+		return append(sts, crcystate.NewStateMergeValue(
+			state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+			state.NewProposalStateValue(r, p.Proposal(), p.Policy(), votingExtension),
+		)), nil, nil
+	}
+
 	if p.Status() != types.Completed {
-		sts = append(sts,
-			crcystate.NewStateMergeValue(
-				st.Key(),
-				state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy()),
-			),
-		)
+		// never pre-snapped, canceled without turnout like the low turnout
+		csts, err := cancelForTurnout(fact.Contract(), fact.ProposalID(), p, common.ZeroBig, opp.height, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to cancel proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		return append(sts, csts...), nil, nil
+	}
 
-		return sts, nil, nil
+	// the vetoes cast in the same block reach the threshold only when merged
+	switch vetoed, err := vetoesReached(fact.Contract(), fact.ProposalID(), getStateFunc); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	case vetoed:
+		vsts, err := vetoProposal(fact.Contract(), fact.ProposalID(), p, opp.height, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to veto proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		return append(sts, vsts...), nil, nil
 	}
 
 	sts = append(sts, crcystate.NewStateMergeValue(
 		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-		state.NewProposalStateValue(types.Executed, p.Proposal(), p.Policy()),
+		state.NewProposalStateValue(types.Executed, p.Proposal(), p.Policy(), p.VotingExtension()),
 	))
 
 	if p.Proposal().Option() == types.ProposalCrypto {
-		cp, _ := p.Proposal().(types.CryptoProposal)
+		cp, ok := p.Proposal().(types.CallDataProposal)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected CallDataProposal, not %T", p.Proposal()), nil
+		}
+
+		csts, err := opp.processCallDatas(ctx, fact.Contract(), cp.CallDatas(), uint64(blockMap.Manifest().ProposedAt().Unix()), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to execute calldata, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, csts...)
+	} else if p.Proposal().Option() == types.ProposalHybrid {
+		hp, ok := p.Proposal().(types.HybridProposal)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected HybridProposal, not %T", p.Proposal()), nil
+		}
+
+		st, err := crcystate.ExistsState(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()), "key of voting power box", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("voting power box not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		vpb, err := state.StateVotingPowerBoxValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("voting power box value not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		// only the call data of the winning option is executed
+		winner, found := vpb.Winner(hp.VoteOptionsCount() - 1)
+		if !found {
+			return nil, base.NewBaseOperationProcessReasonError("winning option not found, %s, %q", fact.Contract(), fact.ProposalID()), nil
+		}
+
+		cd, found := hp.OptionCallData(winner)
+		if !found {
+			return nil, base.NewBaseOperationProcessReasonError("call data of winning option %d not found, %s, %q", winner, fact.Contract(), fact.ProposalID()), nil
+		}
+
+		csts, err := opp.processCallDatas(ctx, fact.Contract(), []types.CallData{cd}, uint64(blockMap.Manifest().ProposedAt().Unix()), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to execute calldata of option %d, %s, %q: %w", winner, fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		sts = append(sts, csts...)
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *ExecuteProcessor) Close() error {
+	executeProcessorPool.Put(opp)
+
+	return nil
+}
 
-		switch cp.CallData().Type() {
-		case types.CalldataTransfer:
-			cd, ok := cp.CallData().(types.TransferCallData)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected TransferCalldata, not %T", cp.CallData()), nil
+// processCallDatas applies the call data in order over working copies of the
+// states they touch. The state merges are returned only when every call data
+// succeeds, so a proposal is executed all together or not at all. Embedded
+// operations are processed against the state before the execution, so their
+// states must not be touched by the other call data. Transfers from accounts
+// other than the dao contract consume the allowances of the senders.
+func (opp *ExecuteProcessor) processCallDatas(
+	ctx context.Context, contract base.Address, callDatas []types.CallData, now uint64, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	var keys []string
+	balances := map[string]crcytypes.Amount{}
+	initials := map[string]common.Big{}
+
+	balance := func(a base.Address, cid crcytypes.CurrencyID) (string, crcytypes.Amount, error) {
+		k := currency.StateKeyBalance(a, cid)
+		if am, found := balances[k]; found {
+			return k, am, nil
+		}
+
+		switch st, found, err := getStateFunc(k); {
+		case err != nil:
+			return "", crcytypes.Amount{}, err
+		case found:
+			am, err := currency.StateBalanceValue(st)
+			if err != nil {
+				return "", crcytypes.Amount{}, err
 			}
 
+			balances[k] = am
+		default:
+			balances[k] = crcytypes.NewAmount(common.ZeroBig, cid)
+		}
+
+		initials[k] = balances[k].Big()
+		keys = append(keys, k)
+
+		return k, balances[k], nil
+	}
+
+	var allowanceKeys []string
+	allowances := map[string]types.Allowance{}
+
+	allowance := func(a base.Address, cid crcytypes.CurrencyID) (string, types.Allowance, error) {
+		k := state.StateKeyAllowance(contract, a, cid)
+		if al, found := allowances[k]; found {
+			return k, al, nil
+		}
+
+		st, err := crcystate.ExistsState(k, "key of allowance", getStateFunc)
+		if err != nil {
+			return "", types.Allowance{}, err
+		}
+
+		al, err := state.StateAllowanceValue(st)
+		if err != nil {
+			return "", types.Allowance{}, err
+		}
+
+		allowances[k] = al
+		allowanceKeys = append(allowanceKeys, k)
+
+		return k, al, nil
+	}
+
+	var design *types.Design
+	var osts []base.StateMergeValue
+	okeys := map[string]int{}
+
+	for i, callData := range callDatas {
+		switch cd := callData.(type) {
+		case types.TransferCallData:
 			if err := crcystate.CheckExistsState(currency.StateKeyAccount(cd.Sender()), getStateFunc); err != nil {
-				return nil, base.NewBaseOperationProcessReasonError("calldata sender not found, %s: %w", cd.Sender(), err), nil
+				return nil, errors.Errorf("calldata %d, sender not found, %s: %v", i, cd.Sender(), err)
 			}
 
 			if err := crcystate.CheckExistsState(currency.StateKeyAccount(cd.Receiver()), getStateFunc); err != nil {
-				return nil, base.NewBaseOperationProcessReasonError("calldata receiver not found, %s: %w", cd.Receiver(), err), nil
+				return nil, errors.Errorf("calldata %d, receiver not found, %s: %v", i, cd.Receiver(), err)
 			}
 
-			st, err = crcystate.ExistsState(currency.StateKeyBalance(cd.Sender(), cd.Amount().Currency()), "key of balance", getStateFunc)
-			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError("failed to find calldata sender balance, %s, %q: %w", cd.Sender(), cd.Amount().Currency(), err), nil
+			if !cd.Sender().Equal(contract) {
+				ak, al, err := allowance(cd.Sender(), cd.Amount().Currency())
+				switch {
+				case err != nil:
+					return nil, errors.Errorf("calldata %d, allowance of sender not found, %s, %q: %v", i, cd.Sender(), cd.Amount().Currency(), err)
+				case al.IsExpired(now):
+					return nil, errors.Errorf("calldata %d, allowance of sender expired, %s, %q", i, cd.Sender(), cd.Amount().Currency())
+				case al.Amount().Compare(cd.Amount().Big()) < 0:
+					return nil, errors.Errorf("calldata %d, not enough allowance of sender, %s, %q", i, cd.Sender(), cd.Amount().Currency())
+				}
+
+				al.SetAmount(al.Amount().Sub(cd.Amount().Big()))
+				allowances[ak] = al
 			}
 
-			sb, err := currency.StateBalanceValue(st)
+			sk, sb, err := balance(cd.Sender(), cd.Amount().Currency())
 			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError("failed to find calldata sender balance value, %s, %q: %w", cd.Sender(), cd.Amount().Currency(), err), nil
+				return nil, errors.Errorf("calldata %d, failed to find sender balance, %s, %q: %v", i, cd.Sender(), cd.Amount().Currency(), err)
 			}
 
-			if sb.Big().Compare(cd.Amount().Big()) >= 0 {
-				sts = append(sts, crcystate.NewStateMergeValue(
-					st.Key(),
-					currency.NewBalanceStateValue(
-						crcytypes.NewAmount(sb.Big().Sub(cd.Amount().Big()), cd.Amount().Currency()),
-					),
-				))
-
-				switch st, found, err := getStateFunc(currency.StateKeyBalance(cd.Receiver(), cd.Amount().Currency())); {
-				case err != nil:
-					return nil, base.NewBaseOperationProcessReasonError("failed to find calldata receiver balance, %s, %q: %w", cd.Receiver(), cd.Amount().Currency(), err), nil
-				case found:
-					rb, err := currency.StateBalanceValue(st)
-					if err != nil {
-						return nil, base.NewBaseOperationProcessReasonError("failed to find calldata receiver balance value, %s, %q: %w", cd.Receiver(), cd.Amount().Currency(), err), nil
-					}
-
-					sts = append(sts, crcystate.NewStateMergeValue(
-						st.Key(),
-						currency.NewBalanceStateValue(
-							crcytypes.NewAmount(rb.Big().Add(cd.Amount().Big()), cd.Amount().Currency()),
-						),
-					))
-				default:
-					sts = append(sts, crcystate.NewStateMergeValue(
-						st.Key(),
-						currency.NewBalanceStateValue(
-							crcytypes.NewAmount(common.ZeroBig.Add(cd.Amount().Big()), cd.Amount().Currency()),
-						),
-					))
-				}
-			}
-		case types.CalldataGovernance:
-			cd, ok := cp.CallData().(types.GovernanceCallData)
-			if !ok {
-				return nil, base.NewBaseOperationProcessReasonError("expected GovernanceCalldata, not %T", cp.CallData()), nil
+			if sb.Big().Compare(cd.Amount().Big()) < 0 {
+				return nil, errors.Errorf("calldata %d, not enough balance of sender, %s, %q", i, cd.Sender(), cd.Amount().Currency())
 			}
+			balances[sk] = sb.WithBig(sb.Big().Sub(cd.Amount().Big()))
 
-			st, err := crcystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+			rk, rb, err := balance(cd.Receiver(), cd.Amount().Currency())
 			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+				return nil, errors.Errorf("calldata %d, failed to find receiver balance, %s, %q: %v", i, cd.Receiver(), cd.Amount().Currency(), err)
 			}
+			balances[rk] = rb.WithBig(rb.Big().Add(cd.Amount().Big()))
+		case types.GovernanceCallData:
+			if design == nil {
+				st, err := crcystate.ExistsState(state.StateKeyDesign(contract), "key of design", getStateFunc)
+				if err != nil {
+					return nil, errors.Errorf("calldata %d, dao design not found, %s: %v", i, contract, err)
+				}
 
-			design, err := state.StateDesignValue(st)
-			if err != nil {
-				return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s: %w", fact.Contract(), err), nil
+				d, err := state.StateDesignValue(st)
+				if err != nil {
+					return nil, errors.Errorf("calldata %d, dao design value not found, %s: %v", i, contract, err)
+				}
+				design = &d
 			}
 
 			nd := types.NewDesign(design.Option(), cd.Policy())
-
 			if err := nd.IsValid(nil); err != nil {
-				sts = append(sts, crcystate.NewStateMergeValue(
-					state.StateKeyDesign(fact.Contract()),
-					state.NewDesignStateValue(
-						nd,
-					),
-				))
+				return nil, errors.Errorf("calldata %d, invalid dao design, %s: %v", i, contract, err)
+			}
+			design = &nd
+		case types.OperationCallData:
+			sts, err := opp.processOperationCallData(ctx, contract, cd, getStateFunc)
+			if err != nil {
+				return nil, errors.Errorf("calldata %d, %v", i, err)
+			}
+
+			for _, st := range sts {
+				if j, found := okeys[st.Key()]; found && j != i {
+					return nil, errors.Errorf("calldata %d, state already changed by calldata %d, %q", i, j, st.Key())
+				}
+
+				okeys[st.Key()] = i
 			}
+
+			osts = append(osts, sts...)
 		default:
-			return nil, base.NewBaseOperationProcessReasonError("invalid calldata, %s, %q", fact.Contract(), fact.ProposalID()), nil
+			return nil, errors.Errorf("calldata %d, unknown calldata, %T", i, callData)
 		}
 	}
 
-	return sts, nil, nil
+	sts := make([]base.StateMergeValue, 0, len(keys)+len(allowanceKeys)+len(osts)+1)
+	for _, k := range keys {
+		if j, found := okeys[k]; found {
+			return nil, errors.Errorf("calldata %d, state also changed by transfer calldata, %q", j, k)
+		}
+
+		// balances are merged by the differences, so the other operations
+		// of the block changing them are not overwritten
+		var v base.StateValue
+
+		switch am := balances[k]; am.Big().Compare(initials[k]) {
+		case 0:
+			continue
+		case 1:
+			v = currency.NewAddBalanceStateValue(am.WithBig(am.Big().Sub(initials[k])))
+		default:
+			v = currency.NewDeductBalanceStateValue(am.WithBig(initials[k].Sub(am.Big())))
+		}
+
+		cid := balances[k].Currency()
+		sts = append(sts, common.NewBaseStateMergeValue(
+			k,
+			v,
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, k, cid, st)
+			},
+		))
+	}
+
+	for _, k := range allowanceKeys {
+		sts = append(sts, crcystate.NewStateMergeValue(k, state.NewAllowanceStateValue(allowances[k])))
+	}
+
+	if design != nil {
+		sts = append(sts, crcystate.NewStateMergeValue(
+			state.StateKeyDesign(contract),
+			state.NewDesignStateValue(*design),
+		))
+	}
+
+	for _, st := range sts {
+		if j, found := okeys[st.Key()]; found {
+			return nil, errors.Errorf("calldata %d, state also changed by transfer or governance calldata, %q", j, st.Key())
+		}
+	}
+
+	return append(sts, osts...), nil
 }
 
-func (opp *ExecuteProcessor) Close() error {
-	executeProcessorPool.Put(opp)
+// processOperationCallData processes the embedded fact with the processor of
+// its operation. The approved proposal authorizes the dao contract as the
+// sender, so the fact is processed without signatures and PreProcess; only
+// the operations of types.OperationCallDataHints are embedded and the checks
+// of their PreProcess on the targets are done by checkOperationCallData.
+func (opp *ExecuteProcessor) processOperationCallData(
+	ctx context.Context, contract base.Address, cd types.OperationCallData, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	if !cd.Sender().Equal(contract) {
+		return nil, errors.Errorf("sender of embedded fact is not the dao contract, %s != %s", cd.Sender(), contract)
+	}
+
+	if opp.newOperationProcessorFunc == nil {
+		return nil, errors.Errorf("operation calldata not supported")
+	}
+
+	if !types.IsOperationCallDataHint(cd.Operation()) {
+		return nil, errors.Errorf("operation not allowed in calldata, %q", cd.Operation())
+	}
+
+	if err := checkOperationCallData(contract, cd.Fact(), getStateFunc); err != nil {
+		return nil, errors.Errorf("invalid %q: %v", cd.Operation(), err)
+	}
+
+	eop := common.NewBaseOperation(cd.Operation(), cd.Fact())
+
+	eopp, err := opp.newOperationProcessorFunc(opp.height, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to create processor of %q: %v", cd.Operation(), err)
+	}
+
+	if c, ok := eopp.(io.Closer); ok {
+		defer func() {
+			_ = c.Close()
+		}()
+	}
+
+	sts, reason, err := eopp.Process(ctx, eop, getStateFunc)
+	switch {
+	case err != nil:
+		return nil, errors.Errorf("failed to process %q: %v", cd.Operation(), err)
+	case reason != nil:
+		return nil, errors.Errorf("failed to process %q: %v", cd.Operation(), reason)
+	}
+
+	return sts, nil
+}
+
+// checkOperationCallData checks the targets of the embedded fact like the
+// PreProcess of its processor does; the accounts created must not exist and
+// the contract accounts withdrawn from must be owned by the dao contract.
+func checkOperationCallData(contract base.Address, fact base.Fact, getStateFunc base.GetStateFunc) error {
+	switch t := fact.(type) {
+	case currencyoperation.CreateAccountFact:
+		targets, err := t.Targets()
+		if err != nil {
+			return errors.Errorf("failed to get targets: %v", err)
+		}
+
+		return checkNewAccounts(targets, getStateFunc)
+	case extensioncurrency.WithdrawFact:
+		targets := make([]base.Address, len(t.Items()))
+		for i, item := range t.Items() {
+			targets[i] = item.Target()
+		}
+
+		return checkOwnedContractAccounts(contract, targets, getStateFunc)
+	default:
+		return errors.Errorf("unsupported fact, %T", fact)
+	}
+}
+
+func checkNewAccounts(targets []base.Address, getStateFunc base.GetStateFunc) error {
+	for _, target := range targets {
+		if err := crcystate.CheckNotExistsState(currency.StateKeyAccount(target), getStateFunc); err != nil {
+			return errors.Errorf("target account already exists, %s: %v", target, err)
+		}
+
+		if err := crcystate.CheckNotExistsState(stextension.StateKeyContractAccount(target), getStateFunc); err != nil {
+			return errors.Errorf("target contract account already exists, %s: %v", target, err)
+		}
+	}
+
+	return nil
+}
+
+func checkOwnedContractAccounts(contract base.Address, targets []base.Address, getStateFunc base.GetStateFunc) error {
+	for _, target := range targets {
+		st, err := crcystate.ExistsState(stextension.StateKeyContractAccount(target), "key of contract account", getStateFunc)
+		if err != nil {
+			return errors.Errorf("target contract account not found, %s: %v", target, err)
+		}
+
+		ca, err := stextension.StateContractAccountValue(st)
+		if err != nil {
+			return errors.Errorf("target contract account value not found, %s: %v", target, err)
+		}
+
+		if !ca.Owner().Equal(contract) {
+			return errors.Errorf("target contract account not owned by the dao contract, %s", target)
+		}
+	}
 
 	return nil
 }
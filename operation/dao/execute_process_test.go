@@ -0,0 +1,108 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/operation/extension"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	stextension "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	crcytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func newTestGetStateFunc(values map[string]base.StateValue) base.GetStateFunc {
+	return func(key string) (base.State, bool, error) {
+		v, found := values[key]
+		if !found {
+			return nil, false, nil
+		}
+
+		return common.NewBaseState(base.Height(1), key, v, nil, nil), true, nil
+	}
+}
+
+func TestCheckOperationCallData(t *testing.T) {
+	contract := base.NewStringAddress("dao")
+	owned := base.NewStringAddress("owned")
+	other := base.NewStringAddress("other")
+	account := base.NewStringAddress("account")
+	created := base.NewStringAddress("created")
+
+	getStateFunc := newTestGetStateFunc(map[string]base.StateValue{
+		currency.StateKeyAccount(account):          currency.NewAccountStateValue(crcytypes.Account{}),
+		stextension.StateKeyContractAccount(owned): stextension.NewContractAccountStateValue(crcytypes.NewContractAccountStatus(contract, nil)),
+		stextension.StateKeyContractAccount(other): stextension.NewContractAccountStateValue(crcytypes.NewContractAccountStatus(account, nil)),
+	})
+
+	withdraw := func(targets ...base.Address) extensioncurrency.WithdrawFact {
+		items := make([]extensioncurrency.WithdrawItem, len(targets))
+		for i := range targets {
+			items[i] = extensioncurrency.NewWithdrawItemMultiAmounts(targets[i], nil)
+		}
+
+		return extensioncurrency.NewWithdrawFact([]byte("token"), contract, items)
+	}
+
+	cases := []struct {
+		name  string
+		check func() error
+		err   bool
+	}{
+		{
+			name:  "create account of new target",
+			check: func() error { return checkNewAccounts([]base.Address{created}, getStateFunc) },
+		},
+		{
+			name:  "create account of existing account",
+			check: func() error { return checkNewAccounts([]base.Address{created, account}, getStateFunc) },
+			err:   true,
+		},
+		{
+			name:  "create account of existing contract account",
+			check: func() error { return checkNewAccounts([]base.Address{owned}, getStateFunc) },
+			err:   true,
+		},
+		{
+			name:  "withdraw from owned contract account",
+			check: func() error { return checkOwnedContractAccounts(contract, []base.Address{owned}, getStateFunc) },
+		},
+		{
+			name:  "withdraw from contract account of other owner",
+			check: func() error { return checkOwnedContractAccounts(contract, []base.Address{owned, other}, getStateFunc) },
+			err:   true,
+		},
+		{
+			name:  "withdraw from not contract account",
+			check: func() error { return checkOwnedContractAccounts(contract, []base.Address{account}, getStateFunc) },
+			err:   true,
+		},
+		{
+			name:  "withdraw fact of owned contract accounts",
+			check: func() error { return checkOperationCallData(contract, withdraw(owned), getStateFunc) },
+		},
+		{
+			name:  "withdraw fact of contract account of other owner",
+			check: func() error { return checkOperationCallData(contract, withdraw(owned, other), getStateFunc) },
+			err:   true,
+		},
+		{
+			name:  "not allowed fact",
+			check: func() error { return checkOperationCallData(contract, VoteFact{}, getStateFunc) },
+			err:   true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.check()
+
+			switch {
+			case c.err && err == nil:
+				t.Fatal("expected error")
+			case !c.err && err != nil:
+				t.Fatal(err)
+			}
+		})
+	}
+}
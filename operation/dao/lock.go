@@ -0,0 +1,135 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	LockFactHint = hint.MustNewHint("mitum-dao-lock-operation-fact-v0.0.1")
+	LockHint     = hint.MustNewHint("mitum-dao-lock-operation-v0.0.1")
+)
+
+type LockFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	amount   common.Big
+	currency currencytypes.CurrencyID
+}
+
+func NewLockFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	amount common.Big,
+	currency currencytypes.CurrencyID,
+) LockFact {
+	bf := base.NewBaseFact(LockFactHint, token)
+	fact := LockFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		amount:   amount,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact LockFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact LockFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact LockFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.amount.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact LockFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.amount,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if !fact.amount.OverZero() {
+		return util.ErrInvalid.Errorf("lock amount must be over zero, %q", fact.amount)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact LockFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact LockFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact LockFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact LockFact) Amount() common.Big {
+	return fact.amount
+}
+
+func (fact LockFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact LockFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type Lock struct {
+	common.BaseOperation
+}
+
+func NewLock(fact LockFact) (Lock, error) {
+	return Lock{BaseOperation: common.NewBaseOperation(LockHint, fact)}, nil
+}
+
+func (op *Lock) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
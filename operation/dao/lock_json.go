@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type LockFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	Amount   common.Big               `json:"amount"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact LockFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Amount:                fact.amount,
+		Currency:              fact.currency,
+	})
+}
+
+type LockFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string `json:"sender"`
+	Contract string `json:"contract"`
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func (fact *LockFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of LockFact")
+
+	var uf LockFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Amount,
+		uf.Currency,
+	)
+}
+
+type LockMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Lock) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Lock) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Lock")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,304 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var lockProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(LockProcessor)
+	},
+}
+
+func (Lock) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type LockProcessor struct {
+	*base.BaseOperationProcessor
+	getLastBlockFunc processor.GetLastBlockFunc
+}
+
+func NewLockProcessor(getLastBlockFunc processor.GetLastBlockFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new LockProcessor")
+
+		nopp := lockProcessorPool.Get()
+		opp, ok := nopp.(*LockProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected LockProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getLastBlockFunc = getLastBlockFunc
+
+		return opp, nil
+	}
+}
+
+func (opp *LockProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess Lock")
+
+	fact, ok := op.Fact().(LockFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not LockFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender cannot be a contract account, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao contract account not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	votingPowerToken := design.Policy().Token()
+
+	switch st, found, err := getStateFunc(state.StateKeyLock(fact.Contract(), fact.Sender())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to find lock state, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	case found:
+		lock, err := state.StateLockValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find lock value from state, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+		}
+
+		if lock.Amount().OverZero() && lock.Currency() != votingPowerToken {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender has locked balance of the previous voting power token, unlock it first, %s, %q",
+				fact.Sender(),
+				lock.Currency(),
+			), nil
+		}
+	}
+
+	required := map[string]common.Big{}
+
+	currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("currency not found, %q: %w", fact.Currency(), err), nil
+	}
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q: %w", fact.Currency(), err), nil
+	}
+
+	required[fact.Currency().String()] = fee
+
+	if _, found := required[votingPowerToken.String()]; !found {
+		required[votingPowerToken.String()] = common.ZeroBig
+	}
+
+	required[votingPowerToken.String()] = required[votingPowerToken.String()].Add(fact.Amount())
+
+	for k, v := range required {
+		st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), currencytypes.CurrencyID(k)), "key of sender balance", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %s, %q: %w", fact.Sender(), k, err), nil
+		}
+
+		switch b, err := currency.StateBalanceValue(st); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %s, %q: %w", fact.Sender(), k, err), nil
+		case b.Big().Compare(v) < 0:
+			return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %s, %q", fact.Sender(), k), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *LockProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Lock")
+
+	fact, ok := op.Fact().(LockFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected LockFact, not %T", op.Fact())
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	votingPowerToken := design.Policy().Token()
+
+	var sts []base.StateMergeValue
+
+	{ // caculate operation fee
+		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q; %w", fact.Currency(), err), nil
+		}
+
+		fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to check fee of currency, %q; %w",
+				fact.Currency(),
+				err,
+			), nil
+		}
+
+		senderBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+			"key of sender balance",
+			getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender balance not found, %q; %w",
+				fact.Sender(),
+				err,
+			), nil
+		}
+
+		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get balance value, %q; %w",
+				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				err,
+			), nil
+		case senderBal.Big().Compare(fee) < 0:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"not enough balance of sender, %q",
+				fact.Sender(),
+			), nil
+		}
+
+		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+		}
+
+		if currencyPolicy.Feeer().Receiver() != nil {
+			if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+				return nil, nil, err
+			} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+				return nil, nil, err
+			} else if !found {
+				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
+				if !ok {
+					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
+				}
+				sts = append(sts, common.NewBaseStateMergeValue(
+					feeRcvrSt.Key(),
+					currency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+					},
+				))
+
+				sts = append(sts, common.NewBaseStateMergeValue(
+					senderBalSt.Key(),
+					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+					},
+				))
+			}
+		}
+	}
+
+	st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), votingPowerToken), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance of voting power token not found, %s, %q: %w", fact.Sender(), votingPowerToken, err), nil
+	}
+
+	sb, err := currency.StateBalanceValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance value of voting power token not found, %s, %q: %w", fact.Sender(), votingPowerToken, err), nil
+	}
+
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			st.Key(),
+			currency.NewDeductBalanceStateValue(sb.WithBig(fact.Amount())),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, currency.StateKeyBalance(fact.Sender(), votingPowerToken), votingPowerToken, st)
+			},
+		),
+	)
+
+	sts = append(sts, common.NewBaseStateMergeValue(
+		state.StateKeyLock(fact.Contract(), fact.Sender()),
+		state.NewLockStateValue(types.NewLockInfo(fact.Sender(), votingPowerToken, fact.Amount(), nil)),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return state.NewLockStateValueMerger(height, state.StateKeyLock(fact.Contract(), fact.Sender()), st)
+		},
+	))
+
+	return sts, nil, nil
+}
+
+func (opp *LockProcessor) Close() error {
+	lockProcessorPool.Put(opp)
+
+	return nil
+}
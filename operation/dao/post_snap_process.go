@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
@@ -31,6 +32,7 @@ func (PostSnap) Process(
 
 type PostSnapProcessor struct {
 	*base.BaseOperationProcessor
+	height           base.Height
 	getLastBlockFunc processor.GetLastBlockFunc
 }
 
@@ -56,6 +58,7 @@ func NewPostSnapProcessor(getLastBlockFunc processor.GetLastBlockFunc) currencyt
 		}
 
 		opp.BaseOperationProcessor = b
+		opp.height = height
 		opp.getLastBlockFunc = getLastBlockFunc
 
 		return opp, nil
@@ -108,7 +111,7 @@ func (opp *PostSnapProcessor) PreProcess(
 
 	if p.Status() == types.Canceled {
 		return nil, base.NewBaseOperationProcessReasonError("already canceled proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
-	} else if p.Status() == types.PostSnapped {
+	} else if p.Status() != types.Proposed && p.Status() != types.PreSnapped {
 		return nil, base.NewBaseOperationProcessReasonError("already post snapped, %s, %q", fact.Contract(), fact.ProposalID()), nil
 	}
 
@@ -155,9 +158,9 @@ func (opp *PostSnapProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	}
 
-	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.PostSnapshot, blockMap)
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.PostSnapshot, blockMap)
 	if period != types.PostSnapshot {
-		return nil, base.NewBaseOperationProcessReasonError("current time is not within the PostSnapshotPeriod, PostSnapshotPeriod; start(%d), end(%d), but now(%d)", start, end, blockMap.Manifest().ProposedAt().Unix()), nil
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within the PostSnapshotPeriod, PostSnapshotPeriod; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
 	}
 
 	var sts []base.StateMergeValue
@@ -177,36 +180,44 @@ func (opp *PostSnapProcessor) Process(
 			), nil
 		}
 
-		senderBalSt, err := currencystate.ExistsState(
-			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
-			"key of sender balance",
+		payer, sponsored, err := feePayer(
+			fact.Contract(), fact.ProposalID(), fact.Sender(), fact.Currency(), fee, currencyPolicy.Feeer().Receiver(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to check fee sponsorship; %w", err), nil
+		}
+		sts = append(sts, sponsored...)
+
+		payerBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(payer, fact.Currency()),
+			"key of payer balance",
 			getStateFunc,
 		)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
-				"sender balance not found, %q; %w",
-				fact.Sender(),
+				"payer balance not found, %q; %w",
+				payer,
 				err,
 			), nil
 		}
 
-		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		switch payerBal, err := currency.StateBalanceValue(payerBalSt); {
 		case err != nil:
 			return nil, base.NewBaseOperationProcessReasonError(
 				"failed to get balance value, %q; %w",
-				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				currency.StateKeyBalance(payer, fact.Currency()),
 				err,
 			), nil
-		case senderBal.Big().Compare(fee) < 0:
+		case payerBal.Big().Compare(fee) < 0:
 			return nil, base.NewBaseOperationProcessReasonError(
-				"not enough balance of sender, %q",
-				fact.Sender(),
+				"not enough balance of payer, %q",
+				payer,
 			), nil
 		}
 
-		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		v, ok := payerBalSt.Value().(currency.BalanceStateValue)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", payerBalSt.Value()), nil
 		}
 
 		if currencyPolicy.Feeer().Receiver() != nil {
@@ -216,7 +227,7 @@ func (opp *PostSnapProcessor) Process(
 				return nil, nil, err
 			} else if !found {
 				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
-			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+			} else if feeRcvrSt.Key() != payerBalSt.Key() {
 				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
 				if !ok {
 					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
@@ -230,10 +241,10 @@ func (opp *PostSnapProcessor) Process(
 				))
 
 				sts = append(sts, common.NewBaseStateMergeValue(
-					senderBalSt.Key(),
+					payerBalSt.Key(),
 					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
 					func(height base.Height, st base.State) base.StateValueMerger {
-						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+						return currency.NewBalanceStateValueMerger(height, payerBalSt.Key(), fact.currency, st)
 					},
 				))
 			}
@@ -241,212 +252,125 @@ func (opp *PostSnapProcessor) Process(
 	}
 
 	if p.Status() != types.PreSnapped {
-		sts = append(sts,
-			currencystate.NewStateMergeValue(
-				st.Key(),
-				state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy()),
-			),
-		)
+		// never pre-snapped, canceled without turnout like the low turnout
+		csts, err := cancelForTurnout(fact.Contract(), fact.ProposalID(), p, common.ZeroBig, opp.height, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to cancel proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
 
-		return sts, nil, nil
+		return append(sts, csts...), nil, nil
 	}
 
-	var ovpb types.VotingPowerBox
-	switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case found:
-		if vb, err := state.StateVotingPowerBoxValue(st); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-		} else {
-			ovpb = vb
-		}
-	default:
-		return nil, base.NewBaseOperationProcessReasonError("voting power box state not found, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	r, tsts, err := tallyProposal(fact.Contract(), fact.ProposalID(), p, opp.height, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to tally proposal, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
 	}
 
-	votingPowerToken := p.Policy().Token()
+	sts = append(sts, currencystate.NewStateMergeValue(
+		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
+		state.NewProposalStateValue(r, p.Proposal(), p.Policy(), p.VotingExtension()),
+	))
+	sts = append(sts, tsts...)
+
+	return sts, nil, nil
+}
+
+func (opp *PostSnapProcessor) Close() error {
+	postSnapProcessorPool.Put(opp)
 
-	var nvpb = types.NewVotingPowerBox(common.ZeroBig, map[string]types.VotingPower{})
+	return nil
+}
 
-	nvps := map[string]types.VotingPower{}
-	nvt := common.ZeroBig
+// tallyRankedChoice runs an instant-runoff count of the ranked ballots over the
+// options below candidates. Each round counts every ballot for its highest
+// ranked continuing option. When no option holds a majority of the counted
+// weight, the options without any weight or else the weakest option are
+// eliminated; a tie for the weakest eliminates the highest option. It reports
+// no winner when all the continuing options are tied.
+func tallyRankedChoice(vpb types.VotingPowerBox, candidates uint8) ([]types.TallyRound, uint8, bool) {
+	continuing := map[uint8]struct{}{}
+	for o := uint8(0); o < candidates; o++ {
+		continuing[o] = struct{}{}
+	}
 
-	votedTotal := common.ZeroBig
-	votingResult := map[uint8]common.Big{}
+	var rounds []types.TallyRound
 
-	switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case found:
-		voters, err := state.StateVotersValue(st)
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voters value, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	for len(continuing) > 0 {
+		options := make([]int, 0, len(continuing))
+		counts := make(map[uint8]common.Big, len(continuing))
+		for o := range continuing {
+			options = append(options, int(o))
+			counts[o] = common.ZeroBig
 		}
+		sort.Ints(options)
 
-		for _, info := range voters {
-			a := info.Account().String()
+		counted := common.ZeroBig
+		exhausted := common.ZeroBig
 
-			if !ovpb.VotingPowers()[a].Voted() {
-				nvp := types.NewVotingPower(info.Account(), common.ZeroBig)
-				nvp.SetVoted(ovpb.VotingPowers()[a].Voted())
-				nvp.SetVoteFor(ovpb.VotingPowers()[a].VoteFor())
-				nvps[a] = nvp
+		for _, vp := range vpb.VotingPowers() {
+			if !vp.Voted() || len(vp.Ballot()) < 1 {
 				continue
 			}
 
-			vp := common.ZeroBig
-			for _, delegator := range info.Delegators() {
-				st, err = currencystate.ExistsState(currency.StateKeyBalance(delegator, votingPowerToken), "key of balance", getStateFunc)
-				if err != nil {
-					continue
-				}
+			found := false
+			for _, o := range vp.Ballot() {
+				if _, ok := continuing[o]; ok {
+					counts[o] = counts[o].Add(vp.Weight())
+					counted = counted.Add(vp.Weight())
+					found = true
 
-				b, err := currency.StateBalanceValue(st)
-				if err != nil {
-					return nil, base.NewBaseOperationProcessReasonError("failed to find balance value of the delegator from state, %s, %q: %w", delegator, votingPowerToken, err), nil
+					break
 				}
-
-				vp = vp.Add(b.Big())
 			}
 
-			ovp := ovpb.VotingPowers()[a]
-			if ovp.Amount().Compare(vp) < 0 {
-				nvps[a] = ovp
-			} else {
-				nvp := types.NewVotingPower(info.Account(), vp)
-				nvp.SetVoted(ovp.Voted())
-				nvp.SetVoteFor(ovp.VoteFor())
-
-				nvps[a] = nvp
+			if !found {
+				exhausted = exhausted.Add(vp.Weight())
 			}
+		}
 
-			nvt = nvt.Add(nvps[a].Amount())
-
-			if nvps[a].Voted() {
-				if _, found := votingResult[nvps[a].VoteFor()]; !found {
-					votingResult[nvps[a].VoteFor()] = common.ZeroBig
-				}
-				votingResult[nvps[a].VoteFor()] = votingResult[nvps[a].VoteFor()].Add(vp)
-				votedTotal = votedTotal.Add(nvps[a].Amount())
+		for _, o := range options {
+			count := counts[uint8(o)]
+			if count.OverZero() && (len(options) == 1 || count.Add(count).Compare(counted) > 0) {
+				return append(rounds, types.NewTallyRound(counts, exhausted, nil)), uint8(o), true
 			}
 		}
 
-		nvpb.SetVotingPowers(nvps)
-		nvpb.SetTotal(nvt)
-		nvpb.SetResult(votingResult)
-	}
-
-	sts = append(sts, currencystate.NewStateMergeValue(
-		state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
-		state.NewVotingPowerBoxStateValue(nvpb),
-	))
-
-	st, err = currencystate.ExistsState(currency.StateKeyCurrencyDesign(votingPowerToken), "key of currency design", getStateFunc)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power token currency state, %q: %w", votingPowerToken, err), nil
-	}
-
-	currencyDesign, err := currency.StateCurrencyDesignValue(st)
-	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power token currency design value from state, %q: %w", votingPowerToken, err), nil
-	}
-
-	actualTurnoutCount := p.Policy().Turnout().Quorum(currencyDesign.Aggregate())
-	actualQuorumCount := p.Policy().Quorum().Quorum(votedTotal)
-
-	r := types.Rejected
-
-	switch {
-	case nvpb.Total().Compare(actualTurnoutCount) < 0:
-		r = types.Canceled
-	case votedTotal.Compare(actualQuorumCount) < 0:
-	case p.Proposal().Option() == types.ProposalCrypto:
-		vr0, found0 := votingResult[0]
-		vr1, found1 := votingResult[1]
-		if !found0 {
-			r = types.Rejected
-			break
-		} else {
-			if found1 {
-				if (0 < vr0.Compare(actualQuorumCount)) && (0 < vr0.Compare(vr1)) {
-					r = types.Completed
-					break
-				}
-			} else {
-				if 0 < vr0.Compare(actualQuorumCount) {
-					r = types.Completed
-					break
-				}
+		var eliminated []uint8
+		for _, o := range options {
+			if !counts[uint8(o)].OverZero() {
+				eliminated = append(eliminated, uint8(o))
 			}
 		}
-	case p.Proposal().Option() == types.ProposalBiz:
-		options := p.Proposal().VoteOptionsCount() - 1
-
-		var count = 0
-		var mvp = common.ZeroBig
-		var i uint8 = 0
-
-		for ; i < options; i++ {
-			if votingResult[i].Compare(actualQuorumCount) >= 0 {
-				if mvp.Compare(votingResult[i]) < 0 {
-					count = 1
-					mvp = votingResult[i]
-				} else if mvp.Equal(votingResult[i]) {
-					count += 1
+
+		if len(eliminated) < 1 {
+			weakest := options[0]
+			tied := 1
+			for _, o := range options[1:] {
+				switch c := counts[uint8(o)].Compare(counts[uint8(weakest)]); {
+				case c < 0:
+					weakest = o
+					tied = 1
+				case c == 0:
+					weakest = o
+					tied++
 				}
 			}
-		}
 
-		if count == 1 {
-			r = types.Completed
+			if tied < len(options) {
+				eliminated = []uint8{uint8(weakest)}
+			}
 		}
-	}
 
-	//if nvpb.Total().Compare(actualTurnoutCount) < 0 {
-	//	r = types.Canceled
-	//} else if votedTotal.Compare(actualQuorumCount) < 0 {
-	//	r = types.Rejected
-	//} else if p.Proposal().Option() == types.ProposalCrypto {
-	//	vr0, found0 := votingResult[0]
-	//	vr1, found1 := votingResult[1]
-	//
-	//	if !(found0 && 0 < vr0.Compare(actualQuorumCount) && (!found1 || (found1 && 0 < vr0.Compare(vr1)))) {
-	//		r = types.Rejected
-	//	}
-	//} else if p.Proposal().Option() == types.ProposalBiz {
-	//	options := p.Proposal().VoteOptionsCount() - 1
-	//
-	//	var count = 0
-	//	var mvp = common.ZeroBig
-	//	var i uint8 = 0
-	//
-	//	for ; i < options; i++ {
-	//		if votingResult[i].Compare(actualQuorumCount) >= 0 {
-	//			if mvp.Compare(votingResult[i]) < 0 {
-	//				count = 1
-	//				mvp = votingResult[i]
-	//			} else if mvp.Equal(votingResult[i]) {
-	//				count += 1
-	//			}
-	//		}
-	//	}
-	//
-	//	if count != 1 {
-	//		r = types.Rejected
-	//	}
-	//}
+		if len(eliminated) < 1 || len(eliminated) == len(options) {
+			return append(rounds, types.NewTallyRound(counts, exhausted, nil)), 0, false
+		}
 
-	sts = append(sts, currencystate.NewStateMergeValue(
-		state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-		state.NewProposalStateValue(r, p.Proposal(), p.Policy()),
-	))
+		rounds = append(rounds, types.NewTallyRound(counts, exhausted, eliminated))
 
-	return sts, nil, nil
-}
-
-func (opp *PostSnapProcessor) Close() error {
-	postSnapProcessorPool.Put(opp)
+		for _, o := range eliminated {
+			delete(continuing, o)
+		}
+	}
 
-	return nil
+	return rounds, 0, false
 }
@@ -0,0 +1,219 @@
+package dao
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-dao/types"
+)
+
+type rankedBallot struct {
+	weight int64
+	ballot types.Ballot
+	voted  bool
+}
+
+type rankedRound struct {
+	counts     map[uint8]int64
+	exhausted  int64
+	eliminated []uint8
+}
+
+func newRankedVotingPowerBox(ballots []rankedBallot) types.VotingPowerBox {
+	total := common.ZeroBig
+	votingPowers := map[string]types.VotingPower{}
+
+	for i, b := range ballots {
+		vp := types.NewVotingPower(nil, common.NewBig(b.weight))
+		vp.SetVoted(b.voted)
+		vp.SetBallot(b.ballot)
+
+		total = total.Add(vp.Amount())
+		votingPowers[fmt.Sprintf("voter%d", i)] = vp
+	}
+
+	return types.NewVotingPowerBox(total, votingPowers)
+}
+
+func TestTallyRankedChoice(t *testing.T) {
+	cases := []struct {
+		name       string
+		candidates uint8
+		ballots    []rankedBallot
+		winner     uint8
+		found      bool
+		rounds     []rankedRound
+	}{
+		{
+			name:       "majority in the first round",
+			candidates: 3,
+			ballots: []rankedBallot{
+				{weight: 5, ballot: types.Ballot{0, 1}, voted: true},
+				{weight: 3, ballot: types.Ballot{1}, voted: true},
+				{weight: 1, ballot: types.Ballot{2, 1}, voted: true},
+			},
+			winner: 0,
+			found:  true,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 5, 1: 3, 2: 1}},
+			},
+		},
+		{
+			name:       "weakest eliminated and transferred",
+			candidates: 3,
+			ballots: []rankedBallot{
+				{weight: 4, ballot: types.Ballot{0}, voted: true},
+				{weight: 3, ballot: types.Ballot{1, 0}, voted: true},
+				{weight: 2, ballot: types.Ballot{2, 1}, voted: true},
+			},
+			winner: 1,
+			found:  true,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 4, 1: 3, 2: 2}, eliminated: []uint8{2}},
+				{counts: map[uint8]int64{0: 4, 1: 5}},
+			},
+		},
+		{
+			name:       "options without weight eliminated together",
+			candidates: 5,
+			ballots: []rankedBallot{
+				{weight: 3, ballot: types.Ballot{0, 3}, voted: true},
+				{weight: 2, ballot: types.Ballot{1, 4}, voted: true},
+				{weight: 2, ballot: types.Ballot{2, 1}, voted: true},
+			},
+			winner: 1,
+			found:  true,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 3, 1: 2, 2: 2, 3: 0, 4: 0}, eliminated: []uint8{3, 4}},
+				{counts: map[uint8]int64{0: 3, 1: 2, 2: 2}, eliminated: []uint8{2}},
+				{counts: map[uint8]int64{0: 3, 1: 4}},
+			},
+		},
+		{
+			name:       "tie for the weakest eliminates the highest option",
+			candidates: 3,
+			ballots: []rankedBallot{
+				{weight: 3, ballot: types.Ballot{0}, voted: true},
+				{weight: 2, ballot: types.Ballot{1, 2}, voted: true},
+				{weight: 2, ballot: types.Ballot{2, 1}, voted: true},
+			},
+			winner: 1,
+			found:  true,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 3, 1: 2, 2: 2}, eliminated: []uint8{2}},
+				{counts: map[uint8]int64{0: 3, 1: 4}},
+			},
+		},
+		{
+			name:       "all continuing options tied",
+			candidates: 2,
+			ballots: []rankedBallot{
+				{weight: 2, ballot: types.Ballot{0, 1}, voted: true},
+				{weight: 2, ballot: types.Ballot{1, 0}, voted: true},
+			},
+			found: false,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 2, 1: 2}},
+			},
+		},
+		{
+			name:       "tied after the elimination",
+			candidates: 3,
+			ballots: []rankedBallot{
+				{weight: 4, ballot: types.Ballot{0}, voted: true},
+				{weight: 3, ballot: types.Ballot{1}, voted: true},
+				{weight: 1, ballot: types.Ballot{2, 1}, voted: true},
+			},
+			found: false,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 4, 1: 3, 2: 1}, eliminated: []uint8{2}},
+				{counts: map[uint8]int64{0: 4, 1: 4}},
+			},
+		},
+		{
+			name:       "exhausted ballots not counted for the majority",
+			candidates: 3,
+			ballots: []rankedBallot{
+				{weight: 3, ballot: types.Ballot{0}, voted: true},
+				{weight: 2, ballot: types.Ballot{1}, voted: true},
+				{weight: 2, ballot: types.Ballot{2}, voted: true},
+			},
+			winner: 0,
+			found:  true,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 3, 1: 2, 2: 2}, eliminated: []uint8{2}},
+				{counts: map[uint8]int64{0: 3, 1: 2}, exhausted: 2},
+			},
+		},
+		{
+			name:       "exhausted ballots over rounds",
+			candidates: 4,
+			ballots: []rankedBallot{
+				{weight: 5, ballot: types.Ballot{0}, voted: true},
+				{weight: 4, ballot: types.Ballot{1}, voted: true},
+				{weight: 2, ballot: types.Ballot{2}, voted: true},
+				{weight: 1, ballot: types.Ballot{3, 2}, voted: true},
+			},
+			winner: 0,
+			found:  true,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 5, 1: 4, 2: 2, 3: 1}, eliminated: []uint8{3}},
+				{counts: map[uint8]int64{0: 5, 1: 4, 2: 3}, eliminated: []uint8{2}},
+				{counts: map[uint8]int64{0: 5, 1: 4}, exhausted: 3},
+			},
+		},
+		{
+			name:       "no ballot",
+			candidates: 2,
+			ballots: []rankedBallot{
+				{weight: 3, ballot: types.Ballot{0}, voted: false},
+				{weight: 2, voted: true},
+			},
+			found: false,
+			rounds: []rankedRound{
+				{counts: map[uint8]int64{0: 0, 1: 0}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rounds, winner, found := tallyRankedChoice(newRankedVotingPowerBox(c.ballots), c.candidates)
+
+			if found != c.found {
+				t.Fatalf("found: expected %v, got %v", c.found, found)
+			}
+
+			if found && winner != c.winner {
+				t.Fatalf("winner: expected %d, got %d", c.winner, winner)
+			}
+
+			if len(rounds) != len(c.rounds) {
+				t.Fatalf("rounds: expected %d, got %d", len(c.rounds), len(rounds))
+			}
+
+			for i, r := range c.rounds {
+				round := rounds[i]
+
+				if len(round.Counts()) != len(r.counts) {
+					t.Fatalf("round %d counts: expected %v, got %v", i, r.counts, round.Counts())
+				}
+
+				for o, count := range r.counts {
+					if v, found := round.Counts()[o]; !found || !v.Equal(common.NewBig(count)) {
+						t.Fatalf("round %d count of option %d: expected %d, got %v", i, o, count, v)
+					}
+				}
+
+				if !round.Exhausted().Equal(common.NewBig(r.exhausted)) {
+					t.Fatalf("round %d exhausted: expected %d, got %v", i, r.exhausted, round.Exhausted())
+				}
+
+				if fmt.Sprint(round.Eliminated()) != fmt.Sprint(r.eliminated) {
+					t.Fatalf("round %d eliminated: expected %v, got %v", i, r.eliminated, round.Eliminated())
+				}
+			}
+		})
+	}
+}
@@ -119,17 +119,15 @@ func (opp *PreSnapProcessor) PreProcess(
 	//	return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	//}
 	//
-	//period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.PreSnapshot, blockMap)
+	//period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.PreSnapshot, blockMap)
 	//if period != types.PreSnapshot {
-	//	return nil, base.NewBaseOperationProcessReasonError("current time is not within the PreSnapshotPeriod, PreSnapshotPeriod; start(%d), end(%d), but now(%d)", start, end, blockMap.Manifest().ProposedAt().Unix()), nil
+	//	return nil, base.NewBaseOperationProcessReasonError("current time is not within the PreSnapshotPeriod, PreSnapshotPeriod; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
 	//}
 
-	if err := currencystate.CheckExistsState(state.StateKeyVoters(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("voters state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	}
-
 	if err := currencystate.CheckExistsState(state.StateKeyDelegators(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("delegators state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		if err := currencystate.CheckExistsState(state.StateKeyDelegation(fact.Contract()), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("neither delegators nor delegation state found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
 	}
 
 	if err := currencystate.CheckNotExistsState(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()), getStateFunc); err != nil {
@@ -171,9 +169,10 @@ func (opp *PreSnapProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	}
 
-	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.PreSnapshot, blockMap)
-	if period != types.PreSnapshot {
-		return nil, base.NewBaseOperationProcessReasonError("current time is not within the PreSnapshotPeriod, PreSnapshotPeriod; start(%d), end(%d), but now(%d)", start, end, blockMap.Manifest().ProposedAt().Unix()), nil
+	// the first vote also takes the pre snapshot, so pre-snap is still available in the voting period
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.PreSnapshot, blockMap)
+	if period != types.PreSnapshot && period != types.Voting {
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within the PreSnapshotPeriod, PreSnapshotPeriod; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
 	}
 
 	var sts []base.StateMergeValue
@@ -193,36 +192,44 @@ func (opp *PreSnapProcessor) Process(
 			), nil
 		}
 
-		senderBalSt, err := currencystate.ExistsState(
-			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
-			"key of sender balance",
+		payer, sponsored, err := feePayer(
+			fact.Contract(), fact.ProposalID(), fact.Sender(), fact.Currency(), fee, currencyPolicy.Feeer().Receiver(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to check fee sponsorship; %w", err), nil
+		}
+		sts = append(sts, sponsored...)
+
+		payerBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(payer, fact.Currency()),
+			"key of payer balance",
 			getStateFunc,
 		)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
-				"sender balance not found, %q; %w",
-				fact.Sender(),
+				"payer balance not found, %q; %w",
+				payer,
 				err,
 			), nil
 		}
 
-		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		switch payerBal, err := currency.StateBalanceValue(payerBalSt); {
 		case err != nil:
 			return nil, base.NewBaseOperationProcessReasonError(
 				"failed to get balance value, %q; %w",
-				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				currency.StateKeyBalance(payer, fact.Currency()),
 				err,
 			), nil
-		case senderBal.Big().Compare(fee) < 0:
+		case payerBal.Big().Compare(fee) < 0:
 			return nil, base.NewBaseOperationProcessReasonError(
-				"not enough balance of sender, %q",
-				fact.Sender(),
+				"not enough balance of payer, %q",
+				payer,
 			), nil
 		}
 
-		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		v, ok := payerBalSt.Value().(currency.BalanceStateValue)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", payerBalSt.Value()), nil
 		}
 
 		if currencyPolicy.Feeer().Receiver() != nil {
@@ -232,7 +239,7 @@ func (opp *PreSnapProcessor) Process(
 				return nil, nil, err
 			} else if !found {
 				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
-			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+			} else if feeRcvrSt.Key() != payerBalSt.Key() {
 				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
 				if !ok {
 					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
@@ -246,113 +253,27 @@ func (opp *PreSnapProcessor) Process(
 				))
 
 				sts = append(sts, common.NewBaseStateMergeValue(
-					senderBalSt.Key(),
+					payerBalSt.Key(),
 					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
 					func(height base.Height, st base.State) base.StateValueMerger {
-						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+						return currency.NewBalanceStateValueMerger(height, payerBalSt.Key(), fact.currency, st)
 					},
 				))
 			}
 		}
 	}
 
-	//st, err := currencystate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "key of proposal", getStateFunc)
-	//if err != nil {
-	//	return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	//}
-	//
-	//p, err := state.StateProposalValue(st)
-	//if err != nil {
-	//	return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	//}
-
-	var votingPowerBox types.VotingPowerBox
-	switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case found:
-		if vb, err := state.StateVotingPowerBoxValue(st); err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-		} else {
-			votingPowerBox = vb
-		}
-	default:
-		votingPowerBox = types.NewVotingPowerBox(common.ZeroBig, map[string]types.VotingPower{})
-	}
-
-	votingPowerToken := p.Policy().Token()
-
-	switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case found:
-		voters, err := state.StateVotersValue(st)
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voters value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-		}
-
-		total := common.ZeroBig
-		votingPowers := map[string]types.VotingPower{}
-		for _, info := range voters {
-			votingPower := common.ZeroBig
-
-			for _, delegator := range info.Delegators() {
-				st, err = currencystate.ExistsState(currency.StateKeyBalance(delegator, votingPowerToken), "key of balance", getStateFunc)
-				if err != nil {
-					continue
-				}
-
-				b, err := currency.StateBalanceValue(st)
-				if err != nil {
-					return nil, base.NewBaseOperationProcessReasonError("failed to find balance value of the delegator from state, %s, %q: %w", delegator, votingPowerToken, err), nil
-				}
-
-				votingPower = votingPower.Add(b.Big())
-			}
-
-			v, found := votingPowers[info.Account().String()]
-			if found {
-				votingPower = v.Amount().Add(votingPower)
-			}
-
-			votingPowers[info.Account().String()] = types.NewVotingPower(info.Account(), votingPower)
-		}
-
-		for _, v := range votingPowers {
-			total = total.Add(v.Amount())
-		}
-		votingPowerBox.SetVotingPowers(votingPowers)
-		votingPowerBox.SetTotal(total)
-	}
-
-	st, err = currencystate.ExistsState(currency.StateKeyCurrencyDesign(votingPowerToken), "key of currency design", getStateFunc)
+	snapshot, err := takePreSnapshot(fact.Contract(), fact.ProposalID(), p, opp.Height(), getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power token currency design, %q: %w", votingPowerToken, err), nil
+		return nil, base.NewBaseOperationProcessReasonError("failed to take pre snapshot, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
 	}
 
-	currencyDesign, err := currency.StateCurrencyDesignValue(st)
+	ssts, err := snapshot.states(fact.Contract(), fact.ProposalID(), p, getStateFunc)
 	if err != nil {
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power token currency design value from state, %q: %w", votingPowerToken, err), nil
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
 	}
 
-	actualTurnoutCount := p.Policy().Turnout().Quorum(currencyDesign.Aggregate())
-	if votingPowerBox.Total().Compare(actualTurnoutCount) < 0 {
-		sts = append(sts, currencystate.NewStateMergeValue(
-			state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-			state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy()),
-		))
-	} else {
-		sts = append(sts,
-			currencystate.NewStateMergeValue(
-				state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-				state.NewProposalStateValue(types.PreSnapped, p.Proposal(), p.Policy()),
-			),
-			currencystate.NewStateMergeValue(
-				state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
-				state.NewVotingPowerBoxStateValue(votingPowerBox),
-			),
-		)
-	}
+	sts = append(sts, ssts...)
 
 	return sts, nil, nil
 }
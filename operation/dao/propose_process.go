@@ -118,6 +118,66 @@ func (opp *ProposeProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("dao option != proposal option, dao(%s) != proposal(%s)", design.Option(), fact.Proposal().Option()), nil
 	}
 
+	// the call data of a hybrid proposal are executed alone by the winning
+	// option, so the allowances are checked for each option
+	var callDataGroups [][]types.CallData
+	switch t := fact.Proposal().(type) {
+	case types.CallDataProposal:
+		callDataGroups = [][]types.CallData{t.CallDatas()}
+	case types.HybridProposal:
+		for _, cd := range t.OptionCallDatas() {
+			callDataGroups = append(callDataGroups, []types.CallData{cd})
+		}
+	}
+
+	i := -1
+	for _, cds := range callDataGroups {
+		allowances := map[string]types.Allowance{}
+
+		for _, cd := range cds {
+			i++
+
+			switch t := cd.(type) {
+			case types.OperationCallData:
+				if !t.Sender().Equal(fact.Contract()) {
+					return nil, base.NewBaseOperationProcessReasonError("sender of embedded fact in calldata %d is not the dao contract, %s != %s", i, t.Sender(), fact.Contract()), nil
+				}
+			case types.TransferCallData:
+				if t.Sender().Equal(fact.Contract()) {
+					continue
+				}
+
+				k := state.StateKeyAllowance(fact.Contract(), t.Sender(), t.Amount().Currency())
+				if _, found := allowances[k]; !found {
+					st, err := currencystate.ExistsState(k, "key of allowance", getStateFunc)
+					if err != nil {
+						return nil, base.NewBaseOperationProcessReasonError("allowance of transfer sender in calldata %d not found, %s, %q: %w", i, t.Sender(), t.Amount().Currency(), err), nil
+					}
+
+					allowance, err := state.StateAllowanceValue(st)
+					if err != nil {
+						return nil, base.NewBaseOperationProcessReasonError("allowance value of transfer sender in calldata %d not found, %s, %q: %w", i, t.Sender(), t.Amount().Currency(), err), nil
+					}
+
+					// the expiry is a block time, so it is compared only with the start in seconds
+					if design.Policy().PeriodUnit() == types.PeriodUnitSecond && allowance.IsExpired(fact.Proposal().StartTime()) {
+						return nil, base.NewBaseOperationProcessReasonError("allowance of transfer sender in calldata %d expired before proposal starts, %s, %q", i, t.Sender(), t.Amount().Currency()), nil
+					}
+
+					allowances[k] = allowance
+				}
+
+				allowance := allowances[k]
+				if allowance.Amount().Compare(t.Amount().Big()) < 0 {
+					return nil, base.NewBaseOperationProcessReasonError("transfer calldata %d exceeds allowance, %s, %q", i, t.Sender(), t.Amount().Currency()), nil
+				}
+
+				allowance.SetAmount(allowance.Amount().Sub(t.Amount().Big()))
+				allowances[k] = allowance
+			}
+		}
+	}
+
 	votingPowerToken := design.Policy().Token()
 	threshold := design.Policy().Threshold()
 	proposeFee := design.Policy().Fee()
@@ -187,7 +247,7 @@ func (opp *ProposeProcessor) Process(
 	sts = append(sts,
 		currencystate.NewStateMergeValue(
 			state.StateKeyProposal(fact.Contract(), fact.ProposalID()),
-			state.NewProposalStateValue(types.Proposed, fact.Proposal(), design.Policy()),
+			state.NewProposalStateValue(types.Proposed, fact.Proposal(), design.Policy(), 0),
 		),
 	)
 
@@ -288,25 +348,44 @@ func (opp *ProposeProcessor) Process(
 		),
 	)
 
-	var cBalance currencytypes.Amount
-	var cBalanceKey string
-	switch st, found, err := getStateFunc(currency.StateKeyBalance(fact.Contract(), proposeFee.Currency())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("contract account balance for propose fee not found, %s, %q: %w", fact.Contract(), proposeFee.Currency(), err), nil
-	case !found:
-		cBalance = currencytypes.NewAmount(common.ZeroBig, proposeFee.Currency())
-		cBalanceKey = st.Key()
-	default:
-		cBalance, err = currency.StateBalanceValue(st)
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			currency.StateKeyBalance(fact.Contract(), proposeFee.Currency()),
+			currency.NewAddBalanceStateValue(proposeFee),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, currency.StateKeyBalance(fact.Contract(), proposeFee.Currency()), proposeFee.Currency(), st)
+			},
+		),
+	)
+
+	if design.Policy().EscrowDeposit() && design.Policy().Threshold().OverZero() {
+		votingPowerToken := design.Policy().Token()
+		threshold := design.Policy().Threshold()
+
+		st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), votingPowerToken), "key of sender balance", getStateFunc)
 		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("contract balance value for propose fee not found, %s, %q: %w", fact.Contract(), proposeFee.Currency(), err), nil
+			return nil, base.NewBaseOperationProcessReasonError("sender balance for deposit not found, %s, %q: %w", fact.Sender(), votingPowerToken, err), nil
 		}
-		cBalanceKey = st.Key()
-	}
 
-	sts = append(sts,
-		currencystate.NewStateMergeValue(cBalanceKey, currency.NewBalanceStateValue(cBalance.WithBig(cBalance.Big().Add(proposeFee.Big())))),
-	)
+		dBalance, err := currency.StateBalanceValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("sender balance value for deposit not found, %s, %q: %w", fact.Sender(), votingPowerToken, err), nil
+		}
+
+		sts = append(sts,
+			common.NewBaseStateMergeValue(
+				st.Key(),
+				currency.NewDeductBalanceStateValue(dBalance.WithBig(threshold)),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return currency.NewBalanceStateValueMerger(height, currency.StateKeyBalance(fact.Sender(), votingPowerToken), votingPowerToken, st)
+				},
+			),
+			currencystate.NewStateMergeValue(
+				state.StateKeyDeposit(fact.Contract(), fact.ProposalID()),
+				state.NewDepositStateValue(types.NewDeposit(fact.Sender(), votingPowerToken, threshold, types.DepositEscrowed)),
+			),
+		)
+	}
 
 	return sts, nil, nil
 }
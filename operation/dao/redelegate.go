@@ -0,0 +1,148 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RedelegateFactHint = hint.MustNewHint("mitum-dao-redelegate-operation-fact-v0.0.1")
+	RedelegateHint     = hint.MustNewHint("mitum-dao-redelegate-operation-v0.0.1")
+)
+
+type RedelegateFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	delegated  base.Address
+	currency   currencytypes.CurrencyID
+}
+
+func NewRedelegateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	delegated base.Address,
+	currency currencytypes.CurrencyID,
+) RedelegateFact {
+	bf := base.NewBaseFact(RedelegateFactHint, token)
+	fact := RedelegateFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		delegated:  delegated,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RedelegateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RedelegateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RedelegateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.delegated.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RedelegateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+		fact.delegated,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RedelegateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RedelegateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RedelegateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RedelegateFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact RedelegateFact) Delegated() base.Address {
+	return fact.delegated
+}
+
+func (fact RedelegateFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RedelegateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.delegated
+
+	return as, nil
+}
+
+type Redelegate struct {
+	common.BaseOperation
+}
+
+func NewRedelegate(fact RedelegateFact) (Redelegate, error) {
+	return Redelegate{BaseOperation: common.NewBaseOperation(RedelegateHint, fact)}, nil
+}
+
+func (op *Redelegate) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
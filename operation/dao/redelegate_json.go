@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RedelegateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Delegated  base.Address             `json:"delegated"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RedelegateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RedelegateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Delegated:             fact.delegated,
+		Currency:              fact.currency,
+	})
+}
+
+type RedelegateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	ProposalID string `json:"proposal_id"`
+	Delegated  string `json:"delegated"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RedelegateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RedelegateFact")
+
+	var uf RedelegateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Delegated,
+		uf.Currency,
+	)
+}
+
+type RedelegateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Redelegate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RedelegateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Redelegate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Redelegate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package dao
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var RegisterItemHint = hint.MustNewHint("mitum-dao-register-item-v0.0.1")
+
+// RegisterItem registers the delegator to the delegated account for the
+// proposal. It is signed by the delegator over RegisterItemMessage with the
+// network id; an item without signs must be pre-authorized, see
+// RegisterItemsProcessor.
+type RegisterItem struct {
+	hint.BaseHinter
+	proposalID string
+	delegator  base.Address
+	delegated  base.Address
+	signs      []base.Sign
+}
+
+func NewRegisterItem(proposalID string, delegator, delegated base.Address, signs []base.Sign) RegisterItem {
+	return RegisterItem{
+		BaseHinter: hint.NewBaseHinter(RegisterItemHint),
+		proposalID: proposalID,
+		delegator:  delegator,
+		delegated:  delegated,
+		signs:      signs,
+	}
+}
+
+// SignRegisterItem signs the registration of the delegator for the proposal of
+// the contract by the private key.
+func SignRegisterItem(
+	priv base.Privatekey, networkID base.NetworkID, contract base.Address, proposalID string, delegator, delegated base.Address,
+) (RegisterItem, error) {
+	sign, err := base.NewBaseSignFromBytes(priv, networkID, RegisterItemMessage(contract, proposalID, delegated))
+	if err != nil {
+		return RegisterItem{}, err
+	}
+
+	return NewRegisterItem(proposalID, delegator, delegated, []base.Sign{sign}), nil
+}
+
+// RegisterItemMessage returns the message signed by the delegator.
+func RegisterItemMessage(contract base.Address, proposalID string, delegated base.Address) []byte {
+	return util.ConcatBytesSlice(
+		contract.Bytes(),
+		[]byte(proposalID),
+		delegated.Bytes(),
+	)
+}
+
+func (it RegisterItem) IsValid([]byte) error {
+	if err := it.BaseHinter.IsValid(RegisterItemHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		it.delegator,
+		it.delegated,
+	); err != nil {
+		return err
+	}
+
+	if len(it.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(it.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	signers := map[string]struct{}{}
+	for i := range it.signs {
+		if err := it.signs[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		signer := it.signs[i].Signer().String()
+		if _, found := signers[signer]; found {
+			return util.ErrInvalid.Errorf("duplicated signer, %s", signer)
+		}
+		signers[signer] = struct{}{}
+	}
+
+	return nil
+}
+
+func (it RegisterItem) Bytes() []byte {
+	bs := make([][]byte, len(it.signs))
+	for i := range it.signs {
+		bs[i] = it.signs[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		[]byte(it.proposalID),
+		it.delegator.Bytes(),
+		it.delegated.Bytes(),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (it RegisterItem) ProposalID() string {
+	return it.proposalID
+}
+
+func (it RegisterItem) Delegator() base.Address {
+	return it.delegator
+}
+
+func (it RegisterItem) Delegated() base.Address {
+	return it.delegated
+}
+
+func (it RegisterItem) Signs() []base.Sign {
+	return it.signs
+}
+
+// Verify verifies the signs of the item for the contract. The signers are
+// checked against the keys of the delegator account separately.
+func (it RegisterItem) Verify(networkID base.NetworkID, contract base.Address) error {
+	msg := RegisterItemMessage(contract, it.proposalID, it.delegated)
+
+	for i := range it.signs {
+		if err := it.signs[i].Verify(networkID, msg); err != nil {
+			return errors.Wrapf(err, "signer %s", it.signs[i].Signer())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it RegisterItem) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       it.Hint().String(),
+			"proposal_id": it.proposalID,
+			"delegator":   it.delegator,
+			"delegated":   it.delegated,
+			"signs":       types.MarshalSignsBSON(it.signs),
+		},
+	)
+}
+
+type RegisterItemBSONUnmarshaler struct {
+	Hint       string                      `bson:"_hint"`
+	ProposalID string                      `bson:"proposal_id"`
+	Delegator  string                      `bson:"delegator"`
+	Delegated  string                      `bson:"delegated"`
+	Signs      []types.SignBSONUnmarshaler `bson:"signs"`
+}
+
+func (it *RegisterItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RegisterItem")
+
+	var u RegisterItemBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	signs, err := types.UnmarshalSignsBSON(u.Signs, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return it.unpack(enc, ht, u.ProposalID, u.Delegator, u.Delegated, signs)
+}
@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *RegisterItem) unpack(enc encoder.Encoder, ht hint.Hint, pid, da, ta string, signs []base.Sign) error {
+	e := util.StringError("failed to unmarshal RegisterItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.proposalID = pid
+	it.signs = signs
+
+	switch a, err := base.DecodeAddress(da, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		it.delegator = a
+	}
+
+	switch a, err := base.DecodeAddress(ta, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		it.delegated = a
+	}
+
+	return nil
+}
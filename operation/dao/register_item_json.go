@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RegisterItemJSONMarshaler struct {
+	hint.BaseHinter
+	ProposalID string       `json:"proposal_id"`
+	Delegator  base.Address `json:"delegator"`
+	Delegated  base.Address `json:"delegated"`
+	Signs      []base.Sign  `json:"signs"`
+}
+
+func (it RegisterItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RegisterItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		ProposalID: it.proposalID,
+		Delegator:  it.delegator,
+		Delegated:  it.delegated,
+		Signs:      it.signs,
+	})
+}
+
+type RegisterItemJSONUnmarshaler struct {
+	Hint       hint.Hint         `json:"_hint"`
+	ProposalID string            `json:"proposal_id"`
+	Delegator  string            `json:"delegator"`
+	Delegated  string            `json:"delegated"`
+	Signs      []json.RawMessage `json:"signs"`
+}
+
+func (it *RegisterItem) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RegisterItem")
+
+	var u RegisterItemJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	signs := make([]base.Sign, len(u.Signs))
+	for i := range u.Signs {
+		var s base.BaseSign
+		if err := s.DecodeJSON(u.Signs[i], enc); err != nil {
+			return e.Wrap(err)
+		}
+
+		signs[i] = s
+	}
+
+	return it.unpack(enc, u.Hint, u.ProposalID, u.Delegator, u.Delegated, signs)
+}
@@ -0,0 +1,171 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+// MaxRegisterItems is the maximum number of items registered in a RegisterItems.
+const MaxRegisterItems = 100
+
+var (
+	RegisterItemsFactHint = hint.MustNewHint("mitum-dao-register-items-operation-fact-v0.0.1")
+	RegisterItemsHint     = hint.MustNewHint("mitum-dao-register-items-operation-v0.0.1")
+)
+
+type RegisterItemsFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	items    []RegisterItem
+	currency currencytypes.CurrencyID
+}
+
+func NewRegisterItemsFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	items []RegisterItem,
+	currency currencytypes.CurrencyID,
+) RegisterItemsFact {
+	bf := base.NewBaseFact(RegisterItemsFactHint, token)
+	fact := RegisterItemsFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		items:    items,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RegisterItemsFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RegisterItemsFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RegisterItemsFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		bs[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RegisterItemsFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > MaxRegisterItems {
+		return util.ErrInvalid.Errorf("items over max, %d > %d", n, MaxRegisterItems)
+	}
+
+	registers := map[string]struct{}{}
+	for i := range fact.items {
+		it := fact.items[i]
+		if err := it.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid item %d: %v", i, err)
+		}
+
+		if it.Delegator().Equal(fact.contract) {
+			return util.ErrInvalid.Errorf("item %d: delegator is same with contract, %q", i, it.Delegator())
+		}
+
+		if it.Delegated().Equal(fact.contract) {
+			return util.ErrInvalid.Errorf("item %d: delegated is same with contract, %q", i, it.Delegated())
+		}
+
+		k := it.ProposalID() + "-" + it.Delegator().String()
+		if _, found := registers[k]; found {
+			return util.ErrInvalid.Errorf("item %d: duplicated delegator for proposal, %q, %q", i, it.Delegator(), it.ProposalID())
+		}
+		registers[k] = struct{}{}
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RegisterItemsFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RegisterItemsFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RegisterItemsFact) Contract() base.Address {
+	return fact.contract
+}
+
+// Items returns the registrations, see SignRegisterItem.
+func (fact RegisterItemsFact) Items() []RegisterItem {
+	return fact.items
+}
+
+func (fact RegisterItemsFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RegisterItemsFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.items)*2+2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	for i := range fact.items {
+		as[i*2+2] = fact.items[i].Delegator()
+		as[i*2+3] = fact.items[i].Delegated()
+	}
+
+	return as, nil
+}
+
+type RegisterItems struct {
+	common.BaseOperation
+}
+
+func NewRegisterItems(fact RegisterItemsFact) (RegisterItems, error) {
+	return RegisterItems{BaseOperation: common.NewBaseOperation(RegisterItemsHint, fact)}, nil
+}
+
+func (op *RegisterItems) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package dao
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *RegisterItemsFact) unpack(enc encoder.Encoder,
+	sa, ca string,
+	ibs []byte,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal RegisterItemsFact")
+
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	hr, err := enc.DecodeSlice(ibs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	items := make([]RegisterItem, len(hr))
+	for i, hinter := range hr {
+		it, ok := hinter.(RegisterItem)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected RegisterItem, not %T", hinter))
+		}
+
+		items[i] = it
+	}
+	fact.items = items
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RegisterItemsFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	Items    []RegisterItem           `json:"items"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RegisterItemsFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RegisterItemsFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Items:                 fact.items,
+		Currency:              fact.currency,
+	})
+}
+
+type RegisterItemsFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string          `json:"sender"`
+	Contract string          `json:"contract"`
+	Items    json.RawMessage `json:"items"`
+	Currency string          `json:"currency"`
+}
+
+func (fact *RegisterItemsFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RegisterItemsFact")
+
+	var uf RegisterItemsFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Items,
+		uf.Currency,
+	)
+}
+
+type RegisterItemsMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RegisterItems) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RegisterItemsMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RegisterItems) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RegisterItems")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,507 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var registerItemsProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RegisterItemsProcessor)
+	},
+}
+
+var registerItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RegisterItemProcessor)
+	},
+}
+
+func (RegisterItems) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+// RegisterItemProcessor checks one item of RegisterItems. An item is
+// authorized by the signs of the delegator, by the delegator being the
+// sender, or by a standing delegation of the delegator to the delegated
+// account.
+type RegisterItemProcessor struct {
+	h         util.Hash
+	sender    base.Address
+	contract  base.Address
+	networkID base.NetworkID
+	item      RegisterItem
+	proposal  state.ProposalStateValue
+}
+
+func (ipp *RegisterItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	st, err := currencystate.ExistsState(state.StateKeyProposal(ipp.contract, it.ProposalID()), "key of proposal", getStateFunc)
+	if err != nil {
+		return errors.Errorf("proposal state not found, %s, %q: %v", ipp.contract, it.ProposalID(), err)
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return errors.Errorf("proposal value not found from state, %s, %q: %v", ipp.contract, it.ProposalID(), err)
+	}
+
+	if p.Status() == types.Canceled {
+		return errors.Errorf("already canceled proposal, %s, %q", ipp.contract, it.ProposalID())
+	}
+	ipp.proposal = p
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(it.Delegator()), getStateFunc); err != nil {
+		return errors.Errorf("delegator not found, %s: %v", it.Delegator(), err)
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(it.Delegator()), getStateFunc); err != nil {
+		return errors.Errorf("delegator cannot be a contract account, %s: %v", it.Delegator(), err)
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyLock(ipp.contract, it.Delegator()), "key of lock", getStateFunc)
+	if err != nil {
+		return errors.Errorf("delegator has no locked balance, %s, %s: %v", ipp.contract, it.Delegator(), err)
+	}
+
+	lock, err := state.StateLockValue(st)
+	if err != nil {
+		return errors.Errorf("lock value not found from state, %s, %s: %v", ipp.contract, it.Delegator(), err)
+	}
+
+	if !lock.Amount().OverZero() {
+		return errors.Errorf("delegator has no locked balance, %s, %s", ipp.contract, it.Delegator())
+	} else if lock.Currency() != p.Policy().Token() {
+		return errors.Errorf(
+			"locked token is not the voting power token of the proposal, %q != %q",
+			lock.Currency(),
+			p.Policy().Token(),
+		)
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyDelegators(ipp.contract, it.ProposalID())); {
+	case err != nil:
+		return errors.Errorf("failed to find delegators state, %s, %q: %v", ipp.contract, it.ProposalID(), err)
+	case found:
+		delegators, err := state.StateDelegatorsValue(st)
+		if err != nil {
+			return errors.Errorf("failed to find delegators value from state, %s, %q: %v", ipp.contract, it.ProposalID(), err)
+		}
+
+		for _, delegator := range delegators {
+			if delegator.Account().Equal(it.Delegator()) {
+				return errors.Errorf("delegator %s already delegates, %s, %q", it.Delegator(), ipp.contract, it.ProposalID())
+			}
+		}
+	}
+
+	switch {
+	case len(it.Signs()) > 0:
+		if err := it.Verify(ipp.networkID, ipp.contract); err != nil {
+			return errors.Errorf("invalid item signing, delegator(%s): %v", it.Delegator(), err)
+		}
+
+		if err := currencystate.CheckFactSignsByState(it.Delegator(), it.Signs(), getStateFunc); err != nil {
+			return errors.Errorf("invalid item signing, delegator(%s): %v", it.Delegator(), err)
+		}
+	case it.Delegator().Equal(ipp.sender):
+	default:
+		authorized := false
+
+		switch st, found, err := getStateFunc(state.StateKeyDelegation(ipp.contract)); {
+		case err != nil:
+			return errors.Errorf("failed to find delegation state, %s: %v", ipp.contract, err)
+		case found:
+			delegations, err := state.StateDelegationValue(st)
+			if err != nil {
+				return errors.Errorf("failed to find delegation value from state, %s: %v", ipp.contract, err)
+			}
+
+			for _, delegation := range delegations {
+				if delegation.Account().Equal(it.Delegator()) && delegation.Delegatee().Equal(it.Delegated()) {
+					authorized = true
+
+					break
+				}
+			}
+		}
+
+		if !authorized {
+			return errors.Errorf(
+				"item neither signed by delegator nor pre-authorized by delegation, %s delegated by %s",
+				it.Delegated(),
+				it.Delegator(),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (ipp *RegisterItemProcessor) Process(
+	_ context.Context, _ base.Operation, _ base.GetStateFunc, blockMap base.BlockMap,
+) error {
+	p := ipp.proposal
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.Registration, blockMap)
+	if period != types.Registration {
+		return errors.Errorf("current time is not within the Registration period, Registration period; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap))
+	}
+
+	return nil
+}
+
+func (ipp *RegisterItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.contract = nil
+	ipp.networkID = nil
+	ipp.item = RegisterItem{}
+	ipp.proposal = state.ProposalStateValue{}
+
+	registerItemProcessorPool.Put(ipp)
+}
+
+type RegisterItemsProcessor struct {
+	*base.BaseOperationProcessor
+	getLastBlockFunc processor.GetLastBlockFunc
+	networkID        base.NetworkID
+}
+
+func NewRegisterItemsProcessor(getLastBlockFunc processor.GetLastBlockFunc, networkID base.NetworkID) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RegisterItemsProcessor")
+
+		nopp := registerItemsProcessorPool.Get()
+		opp, ok := nopp.(*RegisterItemsProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RegisterItemsProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getLastBlockFunc = getLastBlockFunc
+		opp.networkID = networkID
+
+		return opp, nil
+	}
+}
+
+func (opp *RegisterItemsProcessor) newItemProcessor(op base.Operation, fact RegisterItemsFact, it RegisterItem) (*RegisterItemProcessor, error) {
+	nipp := registerItemProcessorPool.Get()
+	ipp, ok := nipp.(*RegisterItemProcessor)
+	if !ok {
+		return nil, errors.Errorf("expected RegisterItemProcessor, not %T", nipp)
+	}
+
+	ipp.h = op.Hash()
+	ipp.sender = fact.Sender()
+	ipp.contract = fact.Contract()
+	ipp.networkID = opp.networkID
+	ipp.item = it
+
+	return ipp, nil
+}
+
+func (opp *RegisterItemsProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess RegisterItems")
+
+	fact, ok := op.Fact().(RegisterItemsFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not RegisterItemsFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender cannot be a contract account, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao contract account not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	for i, it := range fact.Items() {
+		ipp, err := opp.newItemProcessor(op, fact, it)
+		if err != nil {
+			return nil, nil, e.Wrap(err)
+		}
+
+		err = ipp.PreProcess(ctx, op, getStateFunc)
+		ipp.Close()
+
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("item %d: %w", i, err), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RegisterItemsProcessor) Process(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process RegisterItems")
+
+	fact, ok := op.Fact().(RegisterItemsFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected RegisterItemsFact, not %T", op.Fact())
+	}
+
+	blockMap, found, err := opp.getLastBlockFunc()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("get LastBlock failed: %w", err), nil
+	} else if !found {
+		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
+	}
+
+	for i, it := range fact.Items() {
+		ipp, err := opp.newItemProcessor(op, fact, it)
+		if err != nil {
+			return nil, nil, e.Wrap(err)
+		}
+
+		if err := ipp.PreProcess(ctx, op, getStateFunc); err != nil {
+			ipp.Close()
+
+			return nil, base.NewBaseOperationProcessReasonError("item %d: %w", i, err), nil
+		}
+
+		err = ipp.Process(ctx, op, getStateFunc, blockMap)
+		ipp.Close()
+
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("item %d: %w", i, err), nil
+		}
+	}
+
+	sts := []base.StateMergeValue{}
+
+	{ // caculate operation fee
+		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q; %w", fact.Currency(), err), nil
+		}
+
+		fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to check fee of currency, %q; %w",
+				fact.Currency(),
+				err,
+			), nil
+		}
+
+		senderBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+			"key of sender balance",
+			getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender balance not found, %q; %w",
+				fact.Sender(),
+				err,
+			), nil
+		}
+
+		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get balance value, %q; %w",
+				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				err,
+			), nil
+		case senderBal.Big().Compare(fee) < 0:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"not enough balance of sender, %q",
+				fact.Sender(),
+			), nil
+		}
+
+		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+		}
+
+		if currencyPolicy.Feeer().Receiver() != nil {
+			if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+				return nil, nil, err
+			} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+				return nil, nil, err
+			} else if !found {
+				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
+				if !ok {
+					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
+				}
+				sts = append(sts, common.NewBaseStateMergeValue(
+					feeRcvrSt.Key(),
+					currency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+					},
+				))
+
+				sts = append(sts, common.NewBaseStateMergeValue(
+					senderBalSt.Key(),
+					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+					},
+				))
+			}
+		}
+	}
+
+	// the items are merged per proposal, the mergers add the new delegators
+	// to the existing voters and delegators
+	var proposals []string
+	voters := map[string]map[string]types.VoterInfo{}
+	delegators := map[string][]types.DelegatorInfo{}
+
+	var lockAccounts []base.Address
+	locks := map[string]types.LockInfo{}
+	lockProposals := map[string][]string{}
+
+	for _, it := range fact.Items() {
+		pid := it.ProposalID()
+		if _, found := voters[pid]; !found {
+			proposals = append(proposals, pid)
+			voters[pid] = map[string]types.VoterInfo{}
+		}
+
+		k := it.Delegated().String()
+		if v, found := voters[pid][k]; found {
+			voters[pid][k] = types.NewVoterInfo(it.Delegated(), append(v.Delegators(), it.Delegator()))
+		} else {
+			voters[pid][k] = types.NewVoterInfo(it.Delegated(), []base.Address{it.Delegator()})
+		}
+
+		delegators[pid] = append(delegators[pid], types.NewDelegatorInfo(it.Delegator(), it.Delegated()))
+
+		lk := it.Delegator().String()
+		lock, found := locks[lk]
+		if !found {
+			st, err := currencystate.ExistsState(state.StateKeyLock(fact.Contract(), it.Delegator()), "key of lock", getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("lock state not found, %s, %s: %w", fact.Contract(), it.Delegator(), err), nil
+			}
+
+			lock, err = state.StateLockValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("lock value not found from state, %s, %s: %w", fact.Contract(), it.Delegator(), err), nil
+			}
+		}
+
+		if !lock.HasProposal(pid) {
+			if !containsAddress(lockAccounts, it.Delegator()) {
+				lockAccounts = append(lockAccounts, it.Delegator())
+			}
+			lock.SetProposals(append(lock.Proposals(), pid))
+			lockProposals[lk] = append(lockProposals[lk], pid)
+		}
+		locks[lk] = lock
+	}
+
+	for _, pid := range proposals {
+		vs := make([]types.VoterInfo, 0, len(voters[pid]))
+		for _, v := range voters[pid] {
+			vs = append(vs, v)
+		}
+
+		sts = append(sts,
+			common.NewBaseStateMergeValue(
+				state.StateKeyVoters(fact.Contract(), pid),
+				state.NewVotersStateValue(vs),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return state.NewVotersStateValueMerger(height, state.StateKeyVoters(fact.Contract(), pid), st)
+				},
+			),
+			common.NewBaseStateMergeValue(
+				state.StateKeyDelegators(fact.Contract(), pid),
+				state.NewDelegatorsStateValue(delegators[pid]),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return state.NewDelegatorsStateValueMerger(height, state.StateKeyDelegators(fact.Contract(), pid), st)
+				},
+			),
+		)
+	}
+
+	for _, a := range lockAccounts {
+		sts = append(sts, common.NewBaseStateMergeValue(
+			state.StateKeyLock(fact.Contract(), a),
+			state.NewLockStateValue(types.NewLockInfo(a, locks[a.String()].Currency(), common.ZeroBig, lockProposals[a.String()])),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewLockStateValueMerger(height, state.StateKeyLock(fact.Contract(), a), st)
+			},
+		))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *RegisterItemsProcessor) Close() error {
+	registerItemsProcessorPool.Put(opp)
+
+	return nil
+}
+
+func containsAddress(as []base.Address, a base.Address) bool {
+	for i := range as {
+		if as[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -110,6 +110,26 @@ func (opp *RegisterProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("already canceled proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
 	}
 
+	st, err = currencystate.ExistsState(state.StateKeyLock(fact.Contract(), fact.Sender()), "key of lock", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender has no locked balance, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	lock, err := state.StateLockValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock value not found from state, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	if !lock.Amount().OverZero() {
+		return nil, base.NewBaseOperationProcessReasonError("sender has no locked balance, %s, %s", fact.Contract(), fact.Sender()), nil
+	} else if lock.Currency() != p.Policy().Token() {
+		return nil, base.NewBaseOperationProcessReasonError(
+			"locked token is not the voting power token of the proposal, %q != %q",
+			lock.Currency(),
+			p.Policy().Token(),
+		), nil
+	}
+
 	switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
 	case err != nil:
 		return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
@@ -188,9 +208,9 @@ func (opp *RegisterProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	}
 
-	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Registration, blockMap)
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.Registration, blockMap)
 	if period != types.Registration {
-		return nil, base.NewBaseOperationProcessReasonError("current time is not within the Registration period, Registration period; start(%d), end(%d), but now(%d)", start, end, blockMap.Manifest().ProposedAt().Unix()), nil
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within the Registration period, Registration period; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
 	}
 
 	sts := []base.StateMergeValue{}
@@ -210,36 +230,44 @@ func (opp *RegisterProcessor) Process(
 			), nil
 		}
 
-		senderBalSt, err := currencystate.ExistsState(
-			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
-			"key of sender balance",
+		payer, sponsored, err := feePayer(
+			fact.Contract(), fact.ProposalID(), fact.Sender(), fact.Currency(), fee, currencyPolicy.Feeer().Receiver(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to check fee sponsorship; %w", err), nil
+		}
+		sts = append(sts, sponsored...)
+
+		payerBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(payer, fact.Currency()),
+			"key of payer balance",
 			getStateFunc,
 		)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
-				"sender balance not found, %q; %w",
-				fact.Sender(),
+				"payer balance not found, %q; %w",
+				payer,
 				err,
 			), nil
 		}
 
-		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		switch payerBal, err := currency.StateBalanceValue(payerBalSt); {
 		case err != nil:
 			return nil, base.NewBaseOperationProcessReasonError(
 				"failed to get balance value, %q; %w",
-				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				currency.StateKeyBalance(payer, fact.Currency()),
 				err,
 			), nil
-		case senderBal.Big().Compare(fee) < 0:
+		case payerBal.Big().Compare(fee) < 0:
 			return nil, base.NewBaseOperationProcessReasonError(
-				"not enough balance of sender, %q",
-				fact.Sender(),
+				"not enough balance of payer, %q",
+				payer,
 			), nil
 		}
 
-		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		v, ok := payerBalSt.Value().(currency.BalanceStateValue)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", payerBalSt.Value()), nil
 		}
 
 		if currencyPolicy.Feeer().Receiver() != nil {
@@ -249,7 +277,7 @@ func (opp *RegisterProcessor) Process(
 				return nil, nil, err
 			} else if !found {
 				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
-			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+			} else if feeRcvrSt.Key() != payerBalSt.Key() {
 				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
 				if !ok {
 					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
@@ -263,10 +291,10 @@ func (opp *RegisterProcessor) Process(
 				))
 
 				sts = append(sts, common.NewBaseStateMergeValue(
-					senderBalSt.Key(),
+					payerBalSt.Key(),
 					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
 					func(height base.Height, st base.State) base.StateValueMerger {
-						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+						return currency.NewBalanceStateValueMerger(height, payerBalSt.Key(), fact.currency, st)
 					},
 				))
 			}
@@ -345,6 +373,26 @@ func (opp *RegisterProcessor) Process(
 		)
 	}
 
+	st, err = currencystate.ExistsState(state.StateKeyLock(fact.Contract(), fact.Sender()), "key of lock", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock state not found, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	lock, err := state.StateLockValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock value not found from state, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	if !lock.HasProposal(fact.ProposalID()) {
+		sts = append(sts, common.NewBaseStateMergeValue(
+			state.StateKeyLock(fact.Contract(), fact.Sender()),
+			state.NewLockStateValue(types.NewLockInfo(fact.Sender(), lock.Currency(), common.ZeroBig, []string{fact.ProposalID()})),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewLockStateValueMerger(height, state.StateKeyLock(fact.Contract(), fact.Sender()), st)
+			},
+		))
+	}
+
 	return sts, nil, nil
 }
 
@@ -0,0 +1,182 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+// MaxRelayedBallots is the maximum number of ballots relayed in a RelayVotes.
+const MaxRelayedBallots = 100
+
+var (
+	RelayVotesFactHint = hint.MustNewHint("mitum-dao-relay-votes-operation-fact-v0.0.1")
+	RelayVotesHint     = hint.MustNewHint("mitum-dao-relay-votes-operation-v0.0.1")
+)
+
+type RelayVotesFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	ballots    []types.RelayedBallot
+	currency   currencytypes.CurrencyID
+}
+
+func NewRelayVotesFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	ballots []types.RelayedBallot,
+	currency currencytypes.CurrencyID,
+) RelayVotesFact {
+	bf := base.NewBaseFact(RelayVotesFactHint, token)
+	fact := RelayVotesFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		ballots:    ballots,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RelayVotesFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RelayVotesFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RelayVotesFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.ballots))
+	for i := range fact.ballots {
+		bs[i] = fact.ballots[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RelayVotesFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if n := len(fact.ballots); n < 1 {
+		return util.ErrInvalid.Errorf("empty ballots")
+	} else if n > MaxRelayedBallots {
+		return util.ErrInvalid.Errorf("ballots over max, %d > %d", n, MaxRelayedBallots)
+	}
+
+	voters := map[string]struct{}{}
+	for i := range fact.ballots {
+		if err := fact.ballots[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		voter := fact.ballots[i].Voter()
+		if voter.Equal(fact.contract) {
+			return util.ErrInvalid.Errorf("voter is same with contract, %q", voter)
+		}
+
+		if _, found := voters[voter.String()]; found {
+			return util.ErrInvalid.Errorf("duplicated voter, %q", voter)
+		}
+		voters[voter.String()] = struct{}{}
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RelayVotesFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RelayVotesFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RelayVotesFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RelayVotesFact) ProposalID() string {
+	return fact.proposalID
+}
+
+// Ballots returns the votes signed by the voters, see types.SignRelayedBallot.
+func (fact RelayVotesFact) Ballots() []types.RelayedBallot {
+	return fact.ballots
+}
+
+func (fact RelayVotesFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RelayVotesFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, len(fact.ballots)+2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+	for i := range fact.ballots {
+		as[i+2] = fact.ballots[i].Voter()
+	}
+
+	return as, nil
+}
+
+type RelayVotes struct {
+	common.BaseOperation
+}
+
+func NewRelayVotes(fact RelayVotesFact) (RelayVotes, error) {
+	return RelayVotes{BaseOperation: common.NewBaseOperation(RelayVotesHint, fact)}, nil
+}
+
+func (op *RelayVotes) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
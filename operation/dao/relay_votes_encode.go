@@ -0,0 +1,53 @@
+package dao
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *RelayVotesFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string,
+	bbs []byte,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal RelayVotesFact")
+
+	fact.proposalID = pid
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	hr, err := enc.DecodeSlice(bbs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ballots := make([]types.RelayedBallot, len(hr))
+	for i, hinter := range hr {
+		rb, ok := hinter.(types.RelayedBallot)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected RelayedBallot, not %T", hinter))
+		}
+
+		ballots[i] = rb
+	}
+	fact.ballots = ballots
+
+	return nil
+}
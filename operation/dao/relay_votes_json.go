@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RelayVotesFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Ballots    []types.RelayedBallot    `json:"ballots"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RelayVotesFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RelayVotesFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Ballots:               fact.ballots,
+		Currency:              fact.currency,
+	})
+}
+
+type RelayVotesFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string          `json:"sender"`
+	Contract   string          `json:"contract"`
+	ProposalID string          `json:"proposal_id"`
+	Ballots    json.RawMessage `json:"ballots"`
+	Currency   string          `json:"currency"`
+}
+
+func (fact *RelayVotesFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RelayVotesFact")
+
+	var uf RelayVotesFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Ballots,
+		uf.Currency,
+	)
+}
+
+type RelayVotesMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RelayVotes) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RelayVotesMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RelayVotes) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RelayVotes")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
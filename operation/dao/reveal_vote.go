@@ -0,0 +1,171 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RevealVoteFactHint = hint.MustNewHint("mitum-dao-reveal-vote-operation-fact-v0.0.1")
+	RevealVoteHint     = hint.MustNewHint("mitum-dao-reveal-vote-operation-v0.0.1")
+)
+
+type RevealVoteFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	vote       uint8
+	ballot     types.Ballot
+	salt       string
+	currency   currencytypes.CurrencyID
+}
+
+func NewRevealVoteFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	vote uint8,
+	ballot types.Ballot,
+	salt string,
+	currency currencytypes.CurrencyID,
+) RevealVoteFact {
+	bf := base.NewBaseFact(RevealVoteFactHint, token)
+	fact := RevealVoteFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		vote:       vote,
+		ballot:     ballot,
+		salt:       salt,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevealVoteFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevealVoteFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevealVoteFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		util.Uint8ToBytes(fact.vote),
+		fact.ballot.Bytes(),
+		[]byte(fact.salt),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevealVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+		fact.ballot,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if len(fact.salt) == 0 {
+		return util.ErrInvalid.Errorf("empty salt")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RevealVoteFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevealVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevealVoteFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevealVoteFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact RevealVoteFact) Vote() uint8 {
+	return fact.vote
+}
+
+// Ballot returns the options of an approval or a ranked-choice vote;
+// the vote option is not used when the ballot is given.
+func (fact RevealVoteFact) Ballot() types.Ballot {
+	return fact.ballot
+}
+
+// Salt returns the salt of the commitment revealed with the vote.
+func (fact RevealVoteFact) Salt() string {
+	return fact.salt
+}
+
+func (fact RevealVoteFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevealVoteFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type RevealVote struct {
+	common.BaseOperation
+}
+
+func NewRevealVote(fact RevealVoteFact) (RevealVote, error) {
+	return RevealVote{BaseOperation: common.NewBaseOperation(RevealVoteHint, fact)}, nil
+}
+
+func (op *RevealVote) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
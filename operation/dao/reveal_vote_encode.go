@@ -0,0 +1,37 @@
+package dao
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RevealVoteFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string, vt uint8, bl types.Ballot, sl, cid string,
+) error {
+	e := util.StringError("failed to unmarshal RevealVoteFact")
+
+	fact.proposalID = pid
+	fact.vote = vt
+	fact.ballot = bl
+	fact.salt = sl
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RevealVoteFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Vote       uint8                    `json:"vote"`
+	Ballot     types.Ballot             `json:"ballot,omitempty"`
+	Salt       string                   `json:"salt"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevealVoteFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevealVoteFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Vote:                  fact.vote,
+		Ballot:                fact.ballot,
+		Salt:                  fact.salt,
+		Currency:              fact.currency,
+	})
+}
+
+type RevealVoteFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string       `json:"sender"`
+	Contract   string       `json:"contract"`
+	ProposalID string       `json:"proposal_id"`
+	Vote       uint8        `json:"vote"`
+	Ballot     types.Ballot `json:"ballot"`
+	Salt       string       `json:"salt"`
+	Currency   string       `json:"currency"`
+}
+
+func (fact *RevealVoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RevealVoteFact")
+
+	var uf RevealVoteFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Vote,
+		uf.Ballot,
+		uf.Salt,
+		uf.Currency,
+	)
+}
+
+type RevealVoteMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevealVote) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevealVoteMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevealVote) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RevealVote")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
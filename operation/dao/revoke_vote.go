@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RevokeVoteFactHint = hint.MustNewHint("mitum-dao-revoke-vote-operation-fact-v0.0.1")
+	RevokeVoteHint     = hint.MustNewHint("mitum-dao-revoke-vote-operation-v0.0.1")
+)
+
+type RevokeVoteFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	currency   currencytypes.CurrencyID
+}
+
+func NewRevokeVoteFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	currency currencytypes.CurrencyID,
+) RevokeVoteFact {
+	bf := base.NewBaseFact(RevokeVoteFactHint, token)
+	fact := RevokeVoteFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeVoteFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeVoteFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeVoteFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RevokeVoteFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeVoteFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeVoteFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact RevokeVoteFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeVoteFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type RevokeVote struct {
+	common.BaseOperation
+}
+
+func NewRevokeVote(fact RevokeVoteFact) (RevokeVote, error) {
+	return RevokeVote{BaseOperation: common.NewBaseOperation(RevokeVoteHint, fact)}, nil
+}
+
+func (op *RevokeVote) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
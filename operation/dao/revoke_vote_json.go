@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type RevokeVoteFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeVoteFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeVoteFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeVoteFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string `json:"sender"`
+	Contract   string `json:"contract"`
+	ProposalID string `json:"proposal_id"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RevokeVoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeVoteFact")
+
+	var uf RevokeVoteFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Currency,
+	)
+}
+
+type RevokeVoteJSONMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeVote) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeVoteJSONMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeVote) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeVote")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
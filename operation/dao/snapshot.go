@@ -0,0 +1,607 @@
+package dao
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// preSnapshot is the voting powers of the proposal fixed by the locked balances
+// of the delegators when the voting starts. The proposal is canceled when the
+// total locked voting power does not reach the turnout.
+type preSnapshot struct {
+	canceled       bool
+	height         base.Height
+	votingPowerBox types.VotingPowerBox
+	voters         []types.VoterInfo
+	delegators     []types.DelegatorInfo
+	lockSts        []base.StateMergeValue
+}
+
+func takePreSnapshot(
+	contract base.Address, proposalID string, p state.ProposalStateValue, height base.Height, getStateFunc base.GetStateFunc,
+) (preSnapshot, error) {
+	var votingPowerBox types.VotingPowerBox
+	switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(contract, proposalID)); {
+	case err != nil:
+		return preSnapshot{}, errors.Errorf("failed to find voting power box state, %s, %q: %v", contract, proposalID, err)
+	case found:
+		if vb, err := state.StateVotingPowerBoxValue(st); err != nil {
+			return preSnapshot{}, errors.Errorf("failed to find voting power box value from state, %s, %q: %v", contract, proposalID, err)
+		} else {
+			votingPowerBox = vb
+		}
+	default:
+		votingPowerBox = types.NewVotingPowerBox(common.ZeroBig, map[string]types.VotingPower{})
+	}
+
+	votingPowerToken := p.Policy().Token()
+
+	// persistent delegations of the contract are overridden by the delegations registered for the proposal
+	delegations := map[string]types.DelegatorInfo{}
+
+	switch st, found, err := getStateFunc(state.StateKeyDelegation(contract)); {
+	case err != nil:
+		return preSnapshot{}, errors.Errorf("failed to find delegation state, %s: %v", contract, err)
+	case found:
+		ds, err := state.StateDelegationValue(st)
+		if err != nil {
+			return preSnapshot{}, errors.Errorf("failed to find delegation value from state, %s: %v", contract, err)
+		}
+
+		for _, delegation := range ds {
+			delegations[delegation.Account().String()] = delegation
+		}
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyDelegators(contract, proposalID)); {
+	case err != nil:
+		return preSnapshot{}, errors.Errorf("failed to find delegators state, %s, %q: %v", contract, proposalID, err)
+	case found:
+		ds, err := state.StateDelegatorsValue(st)
+		if err != nil {
+			return preSnapshot{}, errors.Errorf("failed to find delegators value from state, %s, %q: %v", contract, proposalID, err)
+		}
+
+		for _, delegator := range ds {
+			delegations[delegator.Account().String()] = delegator
+		}
+	}
+
+	accounts := make([]string, 0, len(delegations))
+	for k := range delegations {
+		accounts = append(accounts, k)
+	}
+	sort.Strings(accounts)
+
+	var delegators []types.DelegatorInfo
+	var voters []types.VoterInfo
+	var lockSts []base.StateMergeValue
+	voterIndexes := map[string]int{}
+	votingPowers := map[string]types.VotingPower{}
+
+	for _, k := range accounts {
+		info := delegations[k]
+		delegators = append(delegators, info)
+
+		a := info.Delegatee().String()
+		if i, found := voterIndexes[a]; found {
+			voters[i].SetDelegators(append(voters[i].Delegators(), info.Account()))
+		} else {
+			voterIndexes[a] = len(voters)
+			voters = append(voters, types.NewVoterInfo(info.Delegatee(), []base.Address{info.Account()}))
+		}
+
+		votingPower := common.ZeroBig
+
+		switch st, found, err := getStateFunc(state.StateKeyLock(contract, info.Account())); {
+		case err != nil:
+			return preSnapshot{}, errors.Errorf("failed to find lock state of the delegator, %s, %s: %v", contract, info.Account(), err)
+		case found:
+			lock, err := state.StateLockValue(st)
+			if err != nil {
+				return preSnapshot{}, errors.Errorf("failed to find lock value of the delegator from state, %s, %s: %v", contract, info.Account(), err)
+			}
+
+			if lock.Currency() != votingPowerToken || !lock.Amount().OverZero() {
+				break
+			}
+
+			votingPower = lock.Amount()
+
+			if !lock.HasProposal(proposalID) {
+				lockSts = append(lockSts, common.NewBaseStateMergeValue(
+					st.Key(),
+					state.NewLockStateValue(types.NewLockInfo(info.Account(), lock.Currency(), common.ZeroBig, []string{proposalID})),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return state.NewLockStateValueMerger(height, state.StateKeyLock(contract, info.Account()), st)
+					},
+				))
+			}
+		}
+
+		if v, found := votingPowers[a]; found {
+			votingPower = v.Amount().Add(votingPower)
+		}
+
+		vp := types.NewVotingPower(info.Delegatee(), votingPower)
+		vp.SetWeight(p.Policy().VotingWeightMode().Weight(votingPower))
+
+		votingPowers[a] = vp
+	}
+
+	sort.Slice(voters, func(i, j int) bool { // NOTE sort by address
+		return strings.Compare(voters[i].Account().String(), voters[j].Account().String()) < 0
+	})
+
+	total := common.ZeroBig
+	for _, v := range votingPowers {
+		total = total.Add(v.Amount())
+	}
+	votingPowerBox.SetVotingPowers(votingPowers)
+	votingPowerBox.SetTotal(total)
+
+	_, actualTurnoutCount, err := turnoutThreshold(p, getStateFunc)
+	if err != nil {
+		return preSnapshot{}, err
+	}
+
+	return preSnapshot{
+		canceled:       votingPowerBox.Total().Compare(actualTurnoutCount) < 0,
+		height:         height,
+		votingPowerBox: votingPowerBox,
+		voters:         voters,
+		delegators:     delegators,
+		lockSts:        lockSts,
+	}, nil
+}
+
+// states returns the state merges of the snapshot. A canceled snapshot only
+// cancels the proposal for low turnout, see cancelForTurnout.
+func (s preSnapshot) states(
+	contract base.Address, proposalID string, p state.ProposalStateValue, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	if s.canceled {
+		return cancelForTurnout(contract, proposalID, p, s.votingPowerBox.Total(), s.height, getStateFunc)
+	}
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposal(contract, proposalID),
+			state.NewProposalStateValue(types.PreSnapped, p.Proposal(), p.Policy(), p.VotingExtension()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyVotingPowerBox(contract, proposalID),
+			state.NewVotingPowerBoxStateValue(s.votingPowerBox),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyVoters(contract, proposalID),
+			state.NewVotersStateValue(s.voters),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyDelegators(contract, proposalID),
+			state.NewDelegatorsStateValue(s.delegators),
+		),
+	}
+
+	return append(sts, s.lockSts...), nil
+}
+
+// turnoutThreshold returns the supply of the voting power token and the
+// turnout threshold measured against the supply. The turnout is measured in
+// the locked amounts whatever the voting weight mode is, which weighs the
+// votes only.
+func turnoutThreshold(p state.ProposalStateValue, getStateFunc base.GetStateFunc) (common.Big, common.Big, error) {
+	votingPowerToken := p.Policy().Token()
+
+	st, err := currencystate.ExistsState(currency.StateKeyCurrencyDesign(votingPowerToken), "key of currency design", getStateFunc)
+	if err != nil {
+		return common.ZeroBig, common.ZeroBig, errors.Errorf("failed to find voting power token currency design, %q: %v", votingPowerToken, err)
+	}
+
+	currencyDesign, err := currency.StateCurrencyDesignValue(st)
+	if err != nil {
+		return common.ZeroBig, common.ZeroBig, errors.Errorf("failed to find voting power token currency design value from state, %q: %v", votingPowerToken, err)
+	}
+
+	return currencyDesign.Aggregate(), p.Policy().Turnout().Quorum(currencyDesign.Aggregate()), nil
+}
+
+// cancelForTurnout returns the state merges canceling the proposal of the
+// turnout under the turnout threshold, at the pre snapshot or never pre-snapped
+// with no turnout. The result is recorded without tally and the deposit is
+// slashed.
+func cancelForTurnout(
+	contract base.Address, proposalID string, p state.ProposalStateValue, turnout common.Big, height base.Height, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	totalSupply, actualTurnoutCount, err := turnoutThreshold(p, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.NewProposalResult(
+		types.Canceled,
+		totalSupply, turnout, actualTurnoutCount,
+		common.ZeroBig, common.ZeroBig, common.ZeroBig,
+		map[uint8]common.Big{},
+		0,
+		false,
+		types.ApprovalRuleTurnout,
+		height,
+	)
+
+	dsts, err := settleDeposit(contract, proposalID, false, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to slash deposit, %s, %q: %v", contract, proposalID, err)
+	}
+
+	return append([]base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposal(contract, proposalID),
+			state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy(), p.VotingExtension()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposalResult(contract, proposalID),
+			state.NewProposalResultStateValue(result),
+		),
+	}, dsts...), nil
+}
+
+// errTallyNotDecrypted is returned by tallyProposal when the decryption shares
+// of the tally committee do not decrypt the encrypted tally.
+var errTallyNotDecrypted = errors.New("tally not decrypted")
+
+// tallyProposal counts the votes of the pre-snapped proposal at the height and
+// returns the result status with the state merges of the counted voting power
+// box, the result record and the deposit settlement. The proposal state itself
+// is left to the caller.
+func tallyProposal(
+	contract base.Address, proposalID string, p state.ProposalStateValue, height base.Height, getStateFunc base.GetStateFunc,
+) (types.ProposalStatus, []base.StateMergeValue, error) {
+	var ovpb types.VotingPowerBox
+	switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(contract, proposalID)); {
+	case err != nil:
+		return types.NilStatus, nil, errors.Errorf("failed to find voting power box state, %s, %q: %v", contract, proposalID, err)
+	case found:
+		if vb, err := state.StateVotingPowerBoxValue(st); err != nil {
+			return types.NilStatus, nil, errors.Errorf("failed to find voting power box value from state, %s, %q: %v", contract, proposalID, err)
+		} else {
+			ovpb = vb
+		}
+	default:
+		return types.NilStatus, nil, errors.Errorf("voting power box state not found, %s, %q", contract, proposalID)
+	}
+
+	// voting powers are fixed by the locked balances at the pre snapshot
+	nvpb := types.NewVotingPowerBox(ovpb.Total(), ovpb.VotingPowers())
+	method := p.Proposal().VotingMethod()
+
+	votedTotal := common.ZeroBig
+	votingResult := map[uint8]common.Big{}
+	for _, vp := range ovpb.VotingPowers() {
+		// unrevealed commitments are not counted, they are reported by
+		// VotingPowerBox.Unrevealed
+		if !vp.Voted() {
+			continue
+		}
+
+		// results are counted in effective weights of the voting weight mode
+		for o, am := range vp.VotedWeights(method) {
+			if _, found := votingResult[o]; !found {
+				votingResult[o] = common.ZeroBig
+			}
+			votingResult[o] = votingResult[o].Add(am)
+
+			if len(vp.Ballot()) < 1 {
+				votedTotal = votedTotal.Add(am)
+			}
+		}
+
+		// a ballot counts the voter once however many options it holds
+		if len(vp.Ballot()) > 0 {
+			votedTotal = votedTotal.Add(vp.Weight())
+		}
+	}
+
+	// encrypted votes are counted by the tally decrypted by the committee
+	if p.Policy().EncryptedVoting() {
+		votedTotal = ovpb.EncryptedVoted()
+
+		if votedTotal.OverZero() {
+			result, err := decryptedResult(contract, proposalID, p, ovpb, getStateFunc)
+			if err != nil {
+				return types.NilStatus, nil, err
+			}
+			votingResult = result
+		}
+	}
+	nvpb.SetResult(votingResult)
+
+	var winner uint8
+	var elected bool
+	if method == types.VotingRankedChoice {
+		var rounds []types.TallyRound
+		rounds, winner, elected = tallyRankedChoice(nvpb, p.Proposal().VoteOptionsCount()-1)
+		nvpb.SetRounds(rounds)
+	}
+
+	totalSupply, actualTurnoutCount, err := turnoutThreshold(p, getStateFunc)
+	if err != nil {
+		return types.NilStatus, nil, err
+	}
+
+	// abstaining votes are left out of the quorum in the ignore abstain mode
+	quorumTotal := votedTotal
+	if v, found := votingResult[p.Proposal().VoteOptionsCount()-1]; found && p.Policy().AbstainMode() == types.AbstainIgnore {
+		quorumTotal = quorumTotal.Sub(v)
+	}
+	actualQuorumCount := p.Policy().Quorum().Quorum(quorumTotal)
+
+	r := types.Rejected
+	rule := types.ApprovalRuleQuorum
+	switch {
+	case nvpb.Total().Compare(actualTurnoutCount) < 0:
+		r = types.Canceled
+		rule = types.ApprovalRuleTurnout
+	case p.Proposal().Option() == types.ProposalCrypto:
+		vrFor, found := votingResult[types.VoteOptionFor]
+		if !found {
+			break
+		}
+
+		// approval is measured against for and against or all votes cast
+		approvalTotal := vrFor
+		if v, found := votingResult[types.VoteOptionAgainst]; found {
+			approvalTotal = approvalTotal.Add(v)
+		}
+		if p.Policy().ApprovalBase() == types.ApprovalBaseCast {
+			approvalTotal = votedTotal
+		}
+
+		if 0 >= vrFor.Compare(actualQuorumCount) || 0 >= vrFor.Compare(approvalTotal.Sub(vrFor)) {
+			break
+		}
+
+		// the strictest approval threshold of the call data decides over the quorum
+		if cp, ok := p.Proposal().(types.CallDataProposal); ok {
+			at, approved := approvedByThreshold(p.Policy().ApprovalThresholds(), cp.CallDatas(), vrFor, approvalTotal)
+			if at != "" {
+				rule = at
+			}
+
+			if !approved {
+				break
+			}
+		}
+
+		r = types.Completed
+	case (p.Proposal().Option() == types.ProposalBiz || p.Proposal().Option() == types.ProposalHybrid) && method == types.VotingRankedChoice:
+		if !elected {
+			break
+		}
+
+		rounds := nvpb.Rounds()
+		if rounds[len(rounds)-1].Counts()[winner].Compare(actualQuorumCount) >= 0 {
+			r = types.Completed
+		}
+	case p.Proposal().Option() == types.ProposalBiz, p.Proposal().Option() == types.ProposalHybrid:
+		options := p.Proposal().VoteOptionsCount() - 1
+
+		var count = 0
+		var mvp = common.ZeroBig
+		var i uint8 = 0
+
+		for ; i < options; i++ {
+			if votingResult[i].Compare(actualQuorumCount) >= 0 {
+				if mvp.Compare(votingResult[i]) < 0 {
+					count = 1
+					mvp = votingResult[i]
+				} else if mvp.Equal(votingResult[i]) {
+					count += 1
+				}
+			}
+		}
+
+		if count == 1 {
+			r = types.Completed
+		}
+	}
+
+	var winning uint8
+	var won bool
+	if r == types.Completed {
+		if p.Proposal().Option() == types.ProposalCrypto {
+			winning, won = types.VoteOptionFor, true
+		} else {
+			winning, won = nvpb.Winner(p.Proposal().VoteOptionsCount() - 1)
+		}
+	}
+
+	// the call data of the winning option of a hybrid proposal is held to its
+	// approval threshold like the call data of a crypto proposal
+	if hp, ok := p.Proposal().(types.HybridProposal); ok && won {
+		if cd, found := hp.OptionCallData(winning); found {
+			votes, approvalTotal := hybridApprovalVotes(nvpb, winning, p.Proposal().VoteOptionsCount()-1, p.Policy().ApprovalBase(), votedTotal)
+
+			at, approved := approvedByThreshold(p.Policy().ApprovalThresholds(), []types.CallData{cd}, votes, approvalTotal)
+			if at != "" {
+				rule = at
+			}
+
+			if !approved {
+				r = types.Rejected
+				winning, won = 0, false
+			}
+		}
+	}
+
+	nvpb.SetRule(rule)
+
+	result := types.NewProposalResult(
+		r,
+		totalSupply, nvpb.Total(), actualTurnoutCount,
+		votedTotal, quorumTotal, actualQuorumCount,
+		votingResult,
+		winning,
+		won,
+		rule,
+		height,
+	)
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyVotingPowerBox(contract, proposalID),
+			state.NewVotingPowerBoxStateValue(nvpb),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposalResult(contract, proposalID),
+			state.NewProposalResultStateValue(result),
+		),
+	}
+
+	// rejected or canceled for low turnout proposals lose the deposit
+	dsts, err := settleDeposit(contract, proposalID, r == types.Completed, getStateFunc)
+	if err != nil {
+		return types.NilStatus, nil, errors.Errorf("failed to settle deposit, %s, %q: %v", contract, proposalID, err)
+	}
+
+	return r, append(sts, dsts...), nil
+}
+
+// approvedByThreshold checks the votes reach the strictest approval threshold
+// of the call data in the approval total. The rule is empty and the votes are
+// approved when no call data has the threshold.
+func approvedByThreshold(
+	thresholds types.ApprovalThresholds, callDatas []types.CallData, votes, approvalTotal common.Big,
+) (string, bool) {
+	at, ratio, found := thresholds.Strictest(callDatas)
+	if !found {
+		return "", true
+	}
+
+	return at, votes.Compare(ratio.Quorum(approvalTotal)) >= 0
+}
+
+// hybridApprovalVotes returns the votes of the winning option of the tallied
+// hybrid proposal and the approval total measuring them; the votes of the
+// options but the abstain, in the last round of the ranked choice tally, or
+// all votes cast in the cast approval base.
+func hybridApprovalVotes(
+	vpb types.VotingPowerBox, winning, abstain uint8, approvalBase types.ApprovalBase, votedTotal common.Big,
+) (common.Big, common.Big) {
+	result := vpb.Result()
+	if rounds := vpb.Rounds(); len(rounds) > 0 {
+		result = rounds[len(rounds)-1].Counts()
+	}
+
+	votes := common.ZeroBig
+	if v, found := result[winning]; found {
+		votes = v
+	}
+
+	if approvalBase == types.ApprovalBaseCast {
+		return votes, votedTotal
+	}
+
+	approvalTotal := common.ZeroBig
+	for o, v := range result {
+		if o != abstain {
+			approvalTotal = approvalTotal.Add(v)
+		}
+	}
+
+	return votes, approvalTotal
+}
+
+// decryptedResult combines the decryption shares of the tally committee and
+// returns the result verified against the encrypted tally.
+func decryptedResult(
+	contract base.Address, proposalID string, p state.ProposalStateValue, vpb types.VotingPowerBox, getStateFunc base.GetStateFunc,
+) (map[uint8]common.Big, error) {
+	tally, err := vpb.EncryptedTally(p.Proposal().VoteOptionsCount())
+	if err != nil {
+		return nil, errors.Errorf("failed to get encrypted tally, %s, %q: %v", contract, proposalID, err)
+	}
+
+	var shares []types.DecryptionShare
+	switch st, found, err := getStateFunc(state.StateKeyDecryptionShares(contract, proposalID)); {
+	case err != nil:
+		return nil, errors.Errorf("failed to find decryption shares state, %s, %q: %v", contract, proposalID, err)
+	case found:
+		if ds, err := state.StateDecryptionSharesValue(st); err != nil {
+			return nil, errors.Errorf("failed to find decryption shares value from state, %s, %q: %v", contract, proposalID, err)
+		} else {
+			shares = ds
+		}
+	}
+
+	result, err := types.VerifyDecryptedResult(p.Policy().TallyCommittee(), tally, shares)
+	if err != nil {
+		return nil, errors.Wrapf(errTallyNotDecrypted, "%s, %q: %v", contract, proposalID, err)
+	}
+
+	// the result is claimed in the unit of the encrypted weights
+	unit := vpb.EncryptedWeightUnit()
+	for o := range result {
+		result[o] = result[o].Mul(unit)
+	}
+
+	return result, nil
+}
+
+// cancelForDecryption returns the state merges canceling the encrypted
+// proposal of the tally not decrypted until the decryption deadline, the end
+// of the ExecutionDelay period. The deposit is refunded as the proposer can not
+// decrypt the tally.
+func cancelForDecryption(
+	contract base.Address, proposalID string, p state.ProposalStateValue, height base.Height, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	st, err := currencystate.ExistsState(state.StateKeyVotingPowerBox(contract, proposalID), "key of voting power box", getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("voting power box state not found, %s, %q: %v", contract, proposalID, err)
+	}
+
+	vpb, err := state.StateVotingPowerBoxValue(st)
+	if err != nil {
+		return nil, errors.Errorf("failed to find voting power box value from state, %s, %q: %v", contract, proposalID, err)
+	}
+
+	totalSupply, actualTurnoutCount, err := turnoutThreshold(p, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.NewProposalResult(
+		types.Canceled,
+		totalSupply, vpb.Total(), actualTurnoutCount,
+		vpb.EncryptedVoted(), common.ZeroBig, common.ZeroBig,
+		map[uint8]common.Big{},
+		0,
+		false,
+		types.ApprovalRuleDecryption,
+		height,
+	)
+
+	dsts, err := settleDeposit(contract, proposalID, true, getStateFunc)
+	if err != nil {
+		return nil, errors.Errorf("failed to refund deposit, %s, %q: %v", contract, proposalID, err)
+	}
+
+	return append([]base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposal(contract, proposalID),
+			state.NewProposalStateValue(types.Canceled, p.Proposal(), p.Policy(), p.VotingExtension()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposalResult(contract, proposalID),
+			state.NewProposalResultStateValue(result),
+		),
+	}, dsts...), nil
+}
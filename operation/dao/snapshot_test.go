@@ -0,0 +1,110 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestHybridApproval(t *testing.T) {
+	hp := types.NewHybridProposal(
+		base.NewStringAddress("proposer"),
+		0,
+		[]types.CallData{types.GovernanceCallData{}, types.TransferCallData{}},
+		types.VotingPlurality,
+		types.ProposalMetadata{},
+	)
+	abstain := hp.VoteOptionsCount() - 1
+
+	results := func(counts map[uint8]int64) map[uint8]common.Big {
+		m := map[uint8]common.Big{}
+		for o, c := range counts {
+			m[o] = common.NewBig(c)
+		}
+
+		return m
+	}
+
+	cases := []struct {
+		name         string
+		thresholds   map[string]types.PercentRatio
+		approvalBase types.ApprovalBase
+		result       map[uint8]int64
+		rounds       []types.TallyRound
+		votedTotal   int64
+		rule         string
+		approved     bool
+	}{
+		{
+			name:         "governance option below threshold",
+			thresholds:   map[string]types.PercentRatio{types.CalldataGovernance: 70},
+			approvalBase: types.ApprovalBaseForAgainst,
+			result:       map[uint8]int64{0: 6, 1: 4, 2: 5},
+			votedTotal:   15,
+			rule:         types.CalldataGovernance,
+		},
+		{
+			name:         "governance option over threshold",
+			thresholds:   map[string]types.PercentRatio{types.CalldataGovernance: 60},
+			approvalBase: types.ApprovalBaseForAgainst,
+			result:       map[uint8]int64{0: 6, 1: 4, 2: 5},
+			votedTotal:   15,
+			rule:         types.CalldataGovernance,
+			approved:     true,
+		},
+		{
+			name:         "governance option below threshold of all votes cast",
+			thresholds:   map[string]types.PercentRatio{types.CalldataGovernance: 60},
+			approvalBase: types.ApprovalBaseCast,
+			result:       map[uint8]int64{0: 6, 1: 4, 2: 5},
+			votedTotal:   15,
+			rule:         types.CalldataGovernance,
+		},
+		{
+			name:         "governance option below threshold in the last round",
+			thresholds:   map[string]types.PercentRatio{types.CalldataGovernance: 70},
+			approvalBase: types.ApprovalBaseForAgainst,
+			result:       map[uint8]int64{0: 6, 1: 4, 2: 0},
+			rounds: []types.TallyRound{
+				types.NewTallyRound(results(map[uint8]int64{0: 5, 1: 4}), common.ZeroBig, nil),
+			},
+			votedTotal: 10,
+			rule:       types.CalldataGovernance,
+		},
+		{
+			name:         "option without threshold",
+			thresholds:   map[string]types.PercentRatio{types.CalldataTransfer: 90},
+			approvalBase: types.ApprovalBaseForAgainst,
+			result:       map[uint8]int64{0: 6, 1: 4, 2: 5},
+			votedTotal:   15,
+			approved:     true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vpb := types.NewVotingPowerBox(common.NewBig(c.votedTotal), nil)
+			vpb.SetResult(results(c.result))
+			vpb.SetRounds(c.rounds)
+
+			winning, won := vpb.Winner(abstain)
+			if !won || winning != 0 {
+				t.Fatalf("expected winning option 0, got %d, %v", winning, won)
+			}
+
+			cd, _ := hp.OptionCallData(winning)
+			votes, approvalTotal := hybridApprovalVotes(vpb, winning, abstain, c.approvalBase, common.NewBig(c.votedTotal))
+
+			rule, approved := approvedByThreshold(types.NewApprovalThresholds(c.thresholds), []types.CallData{cd}, votes, approvalTotal)
+			if rule != c.rule {
+				t.Fatalf("expected rule %q, got %q", c.rule, rule)
+			}
+
+			if approved != c.approved {
+				t.Fatalf("expected approved %v, got %v", c.approved, approved)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package dao
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SplitVoteFactHint = hint.MustNewHint("mitum-dao-split-vote-operation-fact-v0.0.1")
+	SplitVoteHint     = hint.MustNewHint("mitum-dao-split-vote-operation-v0.0.1")
+)
+
+type SplitVoteFact struct {
+	base.BaseFact
+	sender      base.Address
+	contract    base.Address
+	proposalID  string
+	allocations map[uint8]common.Big
+	currency    currencytypes.CurrencyID
+}
+
+func NewSplitVoteFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	allocations map[uint8]common.Big,
+	currency currencytypes.CurrencyID,
+) SplitVoteFact {
+	bf := base.NewBaseFact(SplitVoteFactHint, token)
+	fact := SplitVoteFact{
+		BaseFact:    bf,
+		sender:      sender,
+		contract:    contract,
+		proposalID:  proposalID,
+		allocations: allocations,
+		currency:    currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SplitVoteFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SplitVoteFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SplitVoteFact) Bytes() []byte {
+	options := fact.Options()
+
+	bs := make([][]byte, len(options))
+	for i, o := range options {
+		bs[i] = util.ConcatBytesSlice(util.Uint8ToBytes(o), fact.allocations[o].Bytes())
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		util.ConcatBytesSlice(bs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SplitVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if len(fact.allocations) < 2 {
+		return util.ErrInvalid.Errorf("split vote needs at least two allocations, %d", len(fact.allocations))
+	}
+
+	for o, am := range fact.allocations {
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if !am.OverZero() {
+			return util.ErrInvalid.Errorf("allocation for option %d must be over zero, %q", o, am)
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact SplitVoteFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SplitVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SplitVoteFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact SplitVoteFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact SplitVoteFact) Allocations() map[uint8]common.Big {
+	return fact.allocations
+}
+
+// Options returns the allocated options in ascending order.
+func (fact SplitVoteFact) Options() []uint8 {
+	options := make([]uint8, 0, len(fact.allocations))
+	for o := range fact.allocations {
+		options = append(options, o)
+	}
+
+	sort.Slice(options, func(i, j int) bool {
+		return options[i] < options[j]
+	})
+
+	return options
+}
+
+func (fact SplitVoteFact) Total() common.Big {
+	total := common.ZeroBig
+	for _, am := range fact.allocations {
+		total = total.Add(am)
+	}
+
+	return total
+}
+
+func (fact SplitVoteFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact SplitVoteFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type SplitVote struct {
+	common.BaseOperation
+}
+
+func NewSplitVote(fact SplitVoteFact) (SplitVote, error) {
+	return SplitVote{BaseOperation: common.NewBaseOperation(SplitVoteHint, fact)}, nil
+}
+
+func (op *SplitVote) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
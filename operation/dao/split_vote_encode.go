@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *SplitVoteFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string, al map[uint8]string, cid string,
+) error {
+	e := util.StringError("failed to unmarshal SplitVoteFact")
+
+	fact.proposalID = pid
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	allocations := make(map[uint8]common.Big, len(al))
+	for o, v := range al {
+		big, err := common.NewBigFromString(v)
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		allocations[o] = big
+	}
+	fact.allocations = allocations
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
+
+func allocationStrings(allocations map[uint8]common.Big) map[uint8]string {
+	m := make(map[uint8]string, len(allocations))
+	for o, am := range allocations {
+		m[o] = am.String()
+	}
+
+	return m
+}
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type SplitVoteFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner       base.Address             `json:"sender"`
+	Contract    base.Address             `json:"contract"`
+	ProposalID  string                   `json:"proposal_id"`
+	Allocations map[uint8]string         `json:"allocations"`
+	Currency    currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact SplitVoteFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SplitVoteFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Allocations:           allocationStrings(fact.allocations),
+		Currency:              fact.currency,
+	})
+}
+
+type SplitVoteFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner       string           `json:"sender"`
+	Contract    string           `json:"contract"`
+	ProposalID  string           `json:"proposal_id"`
+	Allocations map[uint8]string `json:"allocations"`
+	Currency    string           `json:"currency"`
+}
+
+func (fact *SplitVoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SplitVoteFact")
+
+	var uf SplitVoteFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Allocations,
+		uf.Currency,
+	)
+}
+
+type SplitVoteMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op SplitVote) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SplitVoteMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SplitVote) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SplitVote")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,412 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var splitVoteProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(SplitVoteProcessor)
+	},
+}
+
+func (SplitVote) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type SplitVoteProcessor struct {
+	*base.BaseOperationProcessor
+	getLastBlockFunc processor.GetLastBlockFunc
+}
+
+func NewSplitVoteProcessor(getLastBlockFunc processor.GetLastBlockFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new SplitVoteProcessor")
+
+		nopp := splitVoteProcessorPool.Get()
+		opp, ok := nopp.(*SplitVoteProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected SplitVoteProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getLastBlockFunc = getLastBlockFunc
+
+		return opp, nil
+	}
+}
+
+func (opp *SplitVoteProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess SplitVote")
+
+	fact, ok := op.Fact().(SplitVoteFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not SplitVoteFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender cannot be a contract account, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao contract account not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "key of proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	if p.Status() == types.Canceled {
+		return nil, base.NewBaseOperationProcessReasonError("already canceled proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	}
+
+	if p.Policy().CommitReveal() {
+		return nil, base.NewBaseOperationProcessReasonError("vote must be committed to proposal in commit-reveal voting, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	}
+
+	if p.Policy().EncryptedVoting() {
+		return nil, base.NewBaseOperationProcessReasonError("vote must be encrypted to proposal in encrypted voting, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	}
+
+	// the first vote of a proposal not pre-snapped yet takes the pre snapshot
+	if p.Status() != types.PreSnapped && p.Status() != types.Proposed {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not in pre-snapped status, %s, %q, %q", fact.Contract(), fact.ProposalID(), p.Status()), nil
+	}
+
+	if p.Status() == types.PreSnapped {
+		switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case !found:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		default:
+			voters, err := state.StateVotersValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to find voters value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			}
+
+			for i, v := range voters {
+				if v.Account().Equal(fact.Sender()) {
+					break
+				}
+
+				if i == len(voters)-1 {
+					return nil, base.NewBaseOperationProcessReasonError("sender is not registered as voter, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+				}
+			}
+		}
+
+		switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case found:
+			vpb, err := state.StateVotingPowerBoxValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			}
+
+			vp, found := vpb.VotingPowers()[fact.Sender().String()]
+			if !found {
+				return nil, base.NewBaseOperationProcessReasonError("sender voting power not found, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			}
+
+			if vp.Voted() && !p.Policy().AllowVoteChange() {
+				return nil, base.NewBaseOperationProcessReasonError("sender already voted, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			}
+
+			if !fact.Total().Equal(vp.Amount()) {
+				return nil, base.NewBaseOperationProcessReasonError("allocations must sum to the voting power of sender, %q != %q, sender(%s), %s, %q", fact.Total(), vp.Amount(), fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			}
+		}
+	}
+
+	if method := p.Proposal().VotingMethod(); method != types.VotingPlurality {
+		return nil, base.NewBaseOperationProcessReasonError("split vote not allowed for %s voting, %s, %q", method, fact.Contract(), fact.ProposalID()), nil
+	}
+
+	for _, o := range fact.Options() {
+		if p.Proposal().VoteOptionsCount() <= o {
+			return nil, base.NewBaseOperationProcessReasonError("invalid vote option, %d, %s, %q", o, fact.Contract(), fact.ProposalID()), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *SplitVoteProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process SplitVote")
+
+	fact, ok := op.Fact().(SplitVoteFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected SplitVoteFact, not %T", op.Fact())
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "key of proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	blockMap, found, err := opp.getLastBlockFunc()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("get LastBlock failed: %w", err), nil
+	} else if !found {
+		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
+	}
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.Voting, blockMap)
+	if period != types.Voting {
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within Voting period, Voting period; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
+	}
+
+	var sts []base.StateMergeValue
+
+	var snapshot preSnapshot
+	snapped := p.Status() == types.Proposed
+
+	var votingPowerBox types.VotingPowerBox
+	if snapped {
+		s, err := takePreSnapshot(fact.Contract(), fact.ProposalID(), p, opp.Height(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to take pre snapshot, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		snapshot = s
+		votingPowerBox = s.votingPowerBox
+	} else {
+		switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case !found:
+			return nil, base.NewBaseOperationProcessReasonError("voting power box state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		default:
+			vpb, err := state.StateVotingPowerBoxValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			}
+			votingPowerBox = vpb
+		}
+	}
+
+	if snapped && snapshot.canceled {
+		// canceled for low turnout, the vote is not counted
+		ssts, err := snapshot.states(fact.Contract(), fact.ProposalID(), p, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+		sts = append(sts, ssts...)
+	} else {
+		vp, found := votingPowerBox.VotingPowers()[fact.Sender().String()]
+		if !found {
+			return nil, base.NewBaseOperationProcessReasonError("sender voting power not found, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+		}
+
+		leader, leading := votingPowerBox.Leader(p.Proposal().VoteOptionsCount() - 1)
+
+		result := votingPowerBox.Result()
+		if vp.Voted() {
+			if !p.Policy().AllowVoteChange() {
+				return nil, base.NewBaseOperationProcessReasonError("sender already voted, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			}
+
+			for o, am := range vp.VotedWeights(types.VotingPlurality) {
+				if r, found := result[o]; found {
+					result[o] = r.Sub(am)
+				}
+			}
+		}
+
+		if !fact.Total().Equal(vp.Amount()) {
+			return nil, base.NewBaseOperationProcessReasonError("allocations must sum to the voting power of sender, %q != %q, sender(%s), %s, %q", fact.Total(), vp.Amount(), fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+		}
+
+		vp.SetVoted(true)
+		vp.SetVoteFor(0)
+		vp.SetAllocations(fact.Allocations())
+		vp.SetBallot(nil)
+
+		vpb := votingPowerBox.VotingPowers()
+		vpb[fact.Sender().String()] = vp
+		votingPowerBox.SetVotingPowers(vpb)
+
+		for o, am := range vp.VotedWeights(types.VotingPlurality) {
+			if _, found := result[o]; found {
+				result[o] = result[o].Add(am)
+			} else {
+				result[o] = common.ZeroBig.Add(am)
+			}
+		}
+		votingPowerBox.SetResult(result)
+
+		np, extended := extendVoting(p, leader, leading, votingPowerBox, blockMap)
+
+		if snapped {
+			snapshot.votingPowerBox = votingPowerBox
+
+			ssts, err := snapshot.states(fact.Contract(), fact.ProposalID(), np, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+			sts = append(sts, ssts...)
+		} else {
+			sts = append(sts,
+				currencystate.NewStateMergeValue(
+					state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
+					state.NewVotingPowerBoxStateValue(votingPowerBox),
+				),
+			)
+
+			if extended {
+				sts = append(sts, currencystate.NewStateMergeValue(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), np))
+			}
+		}
+	}
+
+	{ // caculate operation fee
+		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q; %w", fact.Currency(), err), nil
+		}
+
+		if currencyPolicy.Feeer().Receiver() == nil {
+			return sts, nil, nil
+		}
+
+		fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to check fee of currency, %q; %w",
+				fact.Currency(),
+				err,
+			), nil
+		}
+
+		senderBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+			"key of sender balance",
+			getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender balance not found, %q; %w",
+				fact.Sender(),
+				err,
+			), nil
+		}
+
+		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get balance value, %q; %w",
+				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				err,
+			), nil
+		case senderBal.Big().Compare(fee) < 0:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"not enough balance of sender, %q",
+				fact.Sender(),
+			), nil
+		}
+
+		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+		}
+
+		if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+			return nil, nil, err
+		} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+			return nil, nil, err
+		} else if !found {
+			return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+		} else if feeRcvrSt.Key() != senderBalSt.Key() {
+			r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
+			if !ok {
+				return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
+			}
+			sts = append(sts, common.NewBaseStateMergeValue(
+				feeRcvrSt.Key(),
+				currency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return currency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+				},
+			))
+
+			sts = append(sts, common.NewBaseStateMergeValue(
+				senderBalSt.Key(),
+				currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+				func(height base.Height, st base.State) base.StateValueMerger {
+					return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+				},
+			))
+		}
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *SplitVoteProcessor) Close() error {
+	splitVoteProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+// feePayer returns the account paying the operation fee of the sender for the
+// proposal. The dao contract pays the fee when the fee sponsorship of the
+// current dao policy covers it; the budget and the cap of the sender for the
+// proposal are not exceeded and the contract balance is enough. Otherwise the
+// sender pays. The returned state merge values account the sponsored fee; the
+// budget and the cap are checked against the state before the block, so their
+// mergers reject the fees sponsored together in a block over them.
+func feePayer(
+	contract base.Address,
+	proposalID string,
+	sender base.Address,
+	cid currencytypes.CurrencyID,
+	fee common.Big,
+	receiver base.Address,
+	getStateFunc base.GetStateFunc,
+) (base.Address, []base.StateMergeValue, error) {
+	if receiver == nil || receiver.Equal(contract) || !fee.OverZero() {
+		return sender, nil, nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyDesign(contract), "key of design", getStateFunc)
+	if err != nil {
+		return nil, nil, errors.Errorf("dao design state not found, %s: %v", contract, err)
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, nil, errors.Errorf("dao design value not found from state, %s: %v", contract, err)
+	}
+
+	sponsorship := design.Policy().FeeSponsorship()
+	if !sponsorship.Active() || sponsorship.Currency() != cid {
+		return sender, nil, nil
+	}
+
+	spent := common.ZeroBig
+	switch st, found, err := getStateFunc(state.StateKeySponsorship(contract)); {
+	case err != nil:
+		return nil, nil, errors.Errorf("failed to find sponsorship state, %s: %v", contract, err)
+	case found:
+		if spent, err = state.StateSponsorshipValue(st); err != nil {
+			return nil, nil, errors.Errorf("failed to find sponsorship value from state, %s: %v", contract, err)
+		}
+	}
+
+	if spent.Add(fee).Compare(sponsorship.Budget()) > 0 {
+		return sender, nil, nil
+	}
+
+	used := common.ZeroBig
+	switch st, found, err := getStateFunc(state.StateKeySponsoredFees(contract, proposalID)); {
+	case err != nil:
+		return nil, nil, errors.Errorf("failed to find sponsored fees state, %s, %q: %v", contract, proposalID, err)
+	case found:
+		fees, err := state.StateSponsoredFeesValue(st)
+		if err != nil {
+			return nil, nil, errors.Errorf("failed to find sponsored fees value from state, %s, %q: %v", contract, proposalID, err)
+		}
+
+		for _, f := range fees {
+			if f.Account().Equal(sender) {
+				used = f.Amount()
+
+				break
+			}
+		}
+	}
+
+	if used.Add(fee).Compare(sponsorship.AccountCap()) > 0 {
+		return sender, nil, nil
+	}
+
+	switch st, found, err := getStateFunc(currency.StateKeyBalance(contract, cid)); {
+	case err != nil:
+		return nil, nil, errors.Errorf("failed to find contract balance state, %s, %q: %v", contract, cid, err)
+	case !found:
+		return sender, nil, nil
+	default:
+		bal, err := currency.StateBalanceValue(st)
+		if err != nil {
+			return nil, nil, errors.Errorf("failed to get contract balance value, %s, %q: %v", contract, cid, err)
+		} else if bal.Big().Compare(fee) < 0 {
+			return sender, nil, nil
+		}
+	}
+
+	return contract, []base.StateMergeValue{
+		common.NewBaseStateMergeValue(
+			state.StateKeySponsorship(contract),
+			state.NewSponsorshipStateValue(fee),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewSponsorshipStateValueMerger(height, state.StateKeySponsorship(contract), sponsorship.Budget(), st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			state.StateKeySponsoredFees(contract, proposalID),
+			state.NewSponsoredFeesStateValue([]types.SponsoredFee{types.NewSponsoredFee(sender, fee)}),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewSponsoredFeesStateValueMerger(height, state.StateKeySponsoredFees(contract, proposalID), sponsorship.AccountCap(), st)
+			},
+		),
+	}, nil
+}
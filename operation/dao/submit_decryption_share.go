@@ -0,0 +1,206 @@
+package dao
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SubmitDecryptionShareFactHint = hint.MustNewHint("mitum-dao-submit-decryption-share-operation-fact-v0.0.1")
+	SubmitDecryptionShareHint     = hint.MustNewHint("mitum-dao-submit-decryption-share-operation-v0.0.1")
+)
+
+type SubmitDecryptionShareFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	shares     []string
+	proofs     []string
+	result     map[uint8]common.Big
+	currency   currencytypes.CurrencyID
+}
+
+func NewSubmitDecryptionShareFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	shares []string,
+	proofs []string,
+	result map[uint8]common.Big,
+	currency currencytypes.CurrencyID,
+) SubmitDecryptionShareFact {
+	bf := base.NewBaseFact(SubmitDecryptionShareFactHint, token)
+	fact := SubmitDecryptionShareFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		shares:     shares,
+		proofs:     proofs,
+		result:     result,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SubmitDecryptionShareFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SubmitDecryptionShareFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SubmitDecryptionShareFact) Bytes() []byte {
+	bs := make([][]byte, len(fact.shares)+len(fact.proofs))
+	for i := range fact.shares {
+		bs[i] = []byte(fact.shares[i])
+	}
+
+	for i := range fact.proofs {
+		bs[len(fact.shares)+i] = []byte(fact.proofs[i])
+	}
+
+	options := make([]int, 0, len(fact.result))
+	for o := range fact.result {
+		options = append(options, int(o))
+	}
+	sort.Ints(options)
+
+	rs := make([][]byte, len(options))
+	for i, o := range options {
+		rs[i] = util.ConcatBytesSlice(util.Uint8ToBytes(uint8(o)), fact.result[uint8(o)].Bytes())
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		util.ConcatBytesSlice(bs...),
+		util.ConcatBytesSlice(rs...),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SubmitDecryptionShareFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if len(fact.shares) < 1 {
+		return util.ErrInvalid.Errorf("empty decryption shares")
+	}
+
+	if len(fact.shares) != len(fact.proofs) {
+		return util.ErrInvalid.Errorf("proofs not matched with decryption shares, %d != %d", len(fact.proofs), len(fact.shares))
+	}
+
+	for o, am := range fact.result {
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if am.Int.Sign() < 0 {
+			return util.ErrInvalid.Errorf("negative result of option %d, %q", o, am)
+		}
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact SubmitDecryptionShareFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SubmitDecryptionShareFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SubmitDecryptionShareFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact SubmitDecryptionShareFact) ProposalID() string {
+	return fact.proposalID
+}
+
+// Shares returns the decryption shares of the encrypted tally of each option,
+// see types.CreateDecryptionShares.
+func (fact SubmitDecryptionShareFact) Shares() []string {
+	return fact.shares
+}
+
+func (fact SubmitDecryptionShareFact) Proofs() []string {
+	return fact.proofs
+}
+
+// Result returns the decrypted result claimed by the sender in the
+// VotingPowerBox.EncryptedWeightUnit; it may be empty when the sender leaves
+// the claim to the other members.
+func (fact SubmitDecryptionShareFact) Result() map[uint8]common.Big {
+	return fact.result
+}
+
+func (fact SubmitDecryptionShareFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact SubmitDecryptionShareFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type SubmitDecryptionShare struct {
+	common.BaseOperation
+}
+
+func NewSubmitDecryptionShare(fact SubmitDecryptionShareFact) (SubmitDecryptionShare, error) {
+	return SubmitDecryptionShare{BaseOperation: common.NewBaseOperation(SubmitDecryptionShareHint, fact)}, nil
+}
+
+func (op *SubmitDecryptionShare) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *SubmitDecryptionShareFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string,
+	shs, pfs []string,
+	rs map[uint8]string,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal SubmitDecryptionShareFact")
+
+	fact.proposalID = pid
+	fact.shares = shs
+	fact.proofs = pfs
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	result := make(map[uint8]common.Big, len(rs))
+	for o, v := range rs {
+		big, err := common.NewBigFromString(v)
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		result[o] = big
+	}
+	fact.result = result
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type SubmitDecryptionShareFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Shares     []string                 `json:"shares"`
+	Proofs     []string                 `json:"proofs"`
+	Result     map[uint8]string         `json:"result,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact SubmitDecryptionShareFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SubmitDecryptionShareFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Shares:                fact.shares,
+		Proofs:                fact.proofs,
+		Result:                allocationStrings(fact.result),
+		Currency:              fact.currency,
+	})
+}
+
+type SubmitDecryptionShareFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string           `json:"sender"`
+	Contract   string           `json:"contract"`
+	ProposalID string           `json:"proposal_id"`
+	Shares     []string         `json:"shares"`
+	Proofs     []string         `json:"proofs"`
+	Result     map[uint8]string `json:"result"`
+	Currency   string           `json:"currency"`
+}
+
+func (fact *SubmitDecryptionShareFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SubmitDecryptionShareFact")
+
+	var uf SubmitDecryptionShareFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Shares,
+		uf.Proofs,
+		uf.Result,
+		uf.Currency,
+	)
+}
+
+type SubmitDecryptionShareMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op SubmitDecryptionShare) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SubmitDecryptionShareMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SubmitDecryptionShare) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SubmitDecryptionShare")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
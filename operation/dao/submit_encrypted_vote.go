@@ -0,0 +1,149 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SubmitEncryptedVoteFactHint = hint.MustNewHint("mitum-dao-submit-encrypted-vote-operation-fact-v0.0.1")
+	SubmitEncryptedVoteHint     = hint.MustNewHint("mitum-dao-submit-encrypted-vote-operation-v0.0.1")
+)
+
+type SubmitEncryptedVoteFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	ballot     types.EncryptedBallot
+	currency   currencytypes.CurrencyID
+}
+
+func NewSubmitEncryptedVoteFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	ballot types.EncryptedBallot,
+	currency currencytypes.CurrencyID,
+) SubmitEncryptedVoteFact {
+	bf := base.NewBaseFact(SubmitEncryptedVoteFactHint, token)
+	fact := SubmitEncryptedVoteFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		ballot:     ballot,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SubmitEncryptedVoteFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SubmitEncryptedVoteFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SubmitEncryptedVoteFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.ballot.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact SubmitEncryptedVoteFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.ballot,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact SubmitEncryptedVoteFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SubmitEncryptedVoteFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SubmitEncryptedVoteFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact SubmitEncryptedVoteFact) ProposalID() string {
+	return fact.proposalID
+}
+
+// Ballot returns the vote encrypted to the public key of the tally committee.
+func (fact SubmitEncryptedVoteFact) Ballot() types.EncryptedBallot {
+	return fact.ballot
+}
+
+func (fact SubmitEncryptedVoteFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact SubmitEncryptedVoteFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type SubmitEncryptedVote struct {
+	common.BaseOperation
+}
+
+func NewSubmitEncryptedVote(fact SubmitEncryptedVoteFact) (SubmitEncryptedVote, error) {
+	return SubmitEncryptedVote{BaseOperation: common.NewBaseOperation(SubmitEncryptedVoteHint, fact)}, nil
+}
+
+func (op *SubmitEncryptedVote) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/pkg/errors"
+)
+
+func (fact *SubmitEncryptedVoteFact) unpack(enc encoder.Encoder,
+	sa, ca, pid string,
+	bb []byte,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal SubmitEncryptedVoteFact")
+
+	fact.proposalID = pid
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sa, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(ca, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	if hinter, err := enc.Decode(bb); err != nil {
+		return e.Wrap(err)
+	} else if ballot, ok := hinter.(types.EncryptedBallot); !ok {
+		return e.Wrap(errors.Errorf("expected EncryptedBallot, not %T", hinter))
+	} else {
+		fact.ballot = ballot
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type SubmitEncryptedVoteFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	ProposalID string                   `json:"proposal_id"`
+	Ballot     types.EncryptedBallot    `json:"ballot"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact SubmitEncryptedVoteFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SubmitEncryptedVoteFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		ProposalID:            fact.proposalID,
+		Ballot:                fact.ballot,
+		Currency:              fact.currency,
+	})
+}
+
+type SubmitEncryptedVoteFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner      string          `json:"sender"`
+	Contract   string          `json:"contract"`
+	ProposalID string          `json:"proposal_id"`
+	Ballot     json.RawMessage `json:"ballot"`
+	Currency   string          `json:"currency"`
+}
+
+func (fact *SubmitEncryptedVoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SubmitEncryptedVoteFact")
+
+	var uf SubmitEncryptedVoteFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.ProposalID,
+		uf.Ballot,
+		uf.Currency,
+	)
+}
+
+type SubmitEncryptedVoteMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op SubmitEncryptedVote) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SubmitEncryptedVoteMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *SubmitEncryptedVote) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SubmitEncryptedVote")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UndelegateFactHint = hint.MustNewHint("mitum-dao-undelegate-operation-fact-v0.0.1")
+	UndelegateHint     = hint.MustNewHint("mitum-dao-undelegate-operation-v0.0.1")
+)
+
+type UndelegateFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewUndelegateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	currency currencytypes.CurrencyID,
+) UndelegateFact {
+	bf := base.NewBaseFact(UndelegateFactHint, token)
+	fact := UndelegateFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UndelegateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UndelegateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UndelegateFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UndelegateFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact UndelegateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UndelegateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UndelegateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UndelegateFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UndelegateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type Undelegate struct {
+	common.BaseOperation
+}
+
+func NewUndelegate(fact UndelegateFact) (Undelegate, error) {
+	return Undelegate{BaseOperation: common.NewBaseOperation(UndelegateHint, fact)}, nil
+}
+
+func (op *Undelegate) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+type UndelegateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Owner    base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UndelegateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UndelegateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Owner:                 fact.sender,
+		Contract:              fact.contract,
+		Currency:              fact.currency,
+	})
+}
+
+type UndelegateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Owner    string `json:"sender"`
+	Contract string `json:"contract"`
+	Currency string `json:"currency"`
+}
+
+func (fact *UndelegateFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of UndelegateFact")
+
+	var uf UndelegateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Owner,
+		uf.Contract,
+		uf.Currency,
+	)
+}
+
+type UndelegateJSONMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Undelegate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UndelegateJSONMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Undelegate) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Undelegate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
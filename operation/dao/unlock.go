@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UnlockFactHint = hint.MustNewHint("mitum-dao-unlock-operation-fact-v0.0.1")
+	UnlockHint     = hint.MustNewHint("mitum-dao-unlock-operation-v0.0.1")
+)
+
+type UnlockFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	currency currencytypes.CurrencyID
+}
+
+func NewUnlockFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	currency currencytypes.CurrencyID,
+) UnlockFact {
+	bf := base.NewBaseFact(UnlockFactHint, token)
+	fact := UnlockFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UnlockFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UnlockFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UnlockFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UnlockFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact UnlockFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UnlockFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UnlockFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UnlockFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UnlockFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type Unlock struct {
+	common.BaseOperation
+}
+
+func NewUnlock(fact UnlockFact) (Unlock, error) {
+	return Unlock{BaseOperation: common.NewBaseOperation(UnlockHint, fact)}, nil
+}
+
+func (op *Unlock) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
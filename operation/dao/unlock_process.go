@@ -0,0 +1,266 @@
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var unlockProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UnlockProcessor)
+	},
+}
+
+func (Unlock) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UnlockProcessor struct {
+	*base.BaseOperationProcessor
+	getLastBlockFunc processor.GetLastBlockFunc
+}
+
+func NewUnlockProcessor(getLastBlockFunc processor.GetLastBlockFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UnlockProcessor")
+
+		nopp := unlockProcessorPool.Get()
+		opp, ok := nopp.(*UnlockProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UnlockProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getLastBlockFunc = getLastBlockFunc
+
+		return opp, nil
+	}
+}
+
+func (opp *UnlockProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess Unlock")
+
+	fact, ok := op.Fact().(UnlockFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not UnlockFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender cannot be a contract account, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao contract account not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyLock(fact.Contract(), fact.Sender()), "key of lock", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock state not found, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	lock, err := state.StateLockValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock value not found from state, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	if !lock.Amount().OverZero() {
+		return nil, base.NewBaseOperationProcessReasonError("no locked balance, %s, %s", fact.Contract(), fact.Sender()), nil
+	}
+
+	// the locked balance can be unlocked after every proposal using it is finished
+	for _, pid := range lock.Proposals() {
+		st, err := currencystate.ExistsState(state.StateKeyProposal(fact.Contract(), pid), "key of proposal", getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s, %q: %w", fact.Contract(), pid, err), nil
+		}
+
+		p, err := state.StateProposalValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), pid, err), nil
+		}
+
+		if !p.Status().IsTerminal() {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"locked balance is used by the proposal in progress, %s, %q, status(%d)",
+				fact.Contract(),
+				pid,
+				p.Status(),
+			), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UnlockProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Unlock")
+
+	fact, ok := op.Fact().(UnlockFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected UnlockFact, not %T", op.Fact())
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyLock(fact.Contract(), fact.Sender()), "key of lock", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock state not found, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	lock, err := state.StateLockValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("lock value not found from state, %s, %s: %w", fact.Contract(), fact.Sender(), err), nil
+	}
+
+	var sts []base.StateMergeValue
+
+	{ // caculate operation fee
+		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q; %w", fact.Currency(), err), nil
+		}
+
+		fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to check fee of currency, %q; %w",
+				fact.Currency(),
+				err,
+			), nil
+		}
+
+		senderBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+			"key of sender balance",
+			getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender balance not found, %q; %w",
+				fact.Sender(),
+				err,
+			), nil
+		}
+
+		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get balance value, %q; %w",
+				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				err,
+			), nil
+		case senderBal.Big().Compare(fee) < 0:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"not enough balance of sender, %q",
+				fact.Sender(),
+			), nil
+		}
+
+		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+		}
+
+		if currencyPolicy.Feeer().Receiver() != nil {
+			if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+				return nil, nil, err
+			} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+				return nil, nil, err
+			} else if !found {
+				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
+				if !ok {
+					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
+				}
+				sts = append(sts, common.NewBaseStateMergeValue(
+					feeRcvrSt.Key(),
+					currency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+					},
+				))
+
+				sts = append(sts, common.NewBaseStateMergeValue(
+					senderBalSt.Key(),
+					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+					},
+				))
+			}
+		}
+	}
+
+	sts = append(sts,
+		common.NewBaseStateMergeValue(
+			currency.StateKeyBalance(fact.Sender(), lock.Currency()),
+			currency.NewAddBalanceStateValue(currencytypes.NewAmount(lock.Amount(), lock.Currency())),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return currency.NewBalanceStateValueMerger(height, currency.StateKeyBalance(fact.Sender(), lock.Currency()), lock.Currency(), st)
+			},
+		),
+		common.NewBaseStateMergeValue(
+			state.StateKeyLock(fact.Contract(), fact.Sender()),
+			state.NewRemoveLockStateValue(lock),
+			func(height base.Height, st base.State) base.StateValueMerger {
+				return state.NewLockStateValueMerger(height, state.StateKeyLock(fact.Contract(), fact.Sender()), st)
+			},
+		),
+	)
+
+	return sts, nil, nil
+}
+
+func (opp *UnlockProcessor) Close() error {
+	unlockProcessorPool.Put(opp)
+
+	return nil
+}
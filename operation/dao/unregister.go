@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UnregisterFactHint = hint.MustNewHint("mitum-dao-unregister-operation-fact-v0.0.1")
+	UnregisterHint     = hint.MustNewHint("mitum-dao-unregister-operation-v0.0.1")
+)
+
+type UnregisterFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	currency   currencytypes.CurrencyID
+}
+
+func NewUnregisterFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	currency currencytypes.CurrencyID,
+) UnregisterFact {
+	bf := base.NewBaseFact(UnregisterFactHint, token)
+	fact := UnregisterFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UnregisterFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UnregisterFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UnregisterFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UnregisterFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact UnregisterFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UnregisterFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UnregisterFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UnregisterFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact UnregisterFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UnregisterFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type Unregister struct {
+	common.BaseOperation
+}
+
+func NewUnregister(fact UnregisterFact) (Unregister, error) {
+	return Unregister{BaseOperation: common.NewBaseOperation(UnregisterHint, fact)}, nil
+}
+
+func (op *Unregister) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
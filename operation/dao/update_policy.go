@@ -32,6 +32,20 @@ type UpdatePolicyFact struct {
 	executionDelayPeriod uint64
 	turnout              types.PercentRatio
 	quorum               types.PercentRatio
+	allowVoteChange      bool
+	votingWeightMode     types.VotingWeightMode
+	escrowDeposit        bool
+	guardians            types.GuardianSet
+	periodUnit           types.PeriodUnit
+	approvalThresholds   types.ApprovalThresholds
+	abstainMode          types.AbstainMode
+	approvalBase         types.ApprovalBase
+	antiSnipingWindow    uint64
+	antiSnipingExtension uint64
+	revealPeriod         uint64
+	tallyCommittee       types.TallyCommittee
+	relayFeeReimbursed   bool
+	feeSponsorship       types.FeeSponsorship
 	currency             currencytypes.CurrencyID
 }
 
@@ -51,6 +65,20 @@ func NewUpdatePolicyFact(
 	postSnapshotPeriod,
 	executionDelayPeriod uint64,
 	turnout, quorum types.PercentRatio,
+	allowVoteChange bool,
+	votingWeightMode types.VotingWeightMode,
+	escrowDeposit bool,
+	guardians types.GuardianSet,
+	periodUnit types.PeriodUnit,
+	approvalThresholds types.ApprovalThresholds,
+	abstainMode types.AbstainMode,
+	approvalBase types.ApprovalBase,
+	antiSnipingWindow uint64,
+	antiSnipingExtension uint64,
+	revealPeriod uint64,
+	tallyCommittee types.TallyCommittee,
+	relayFeeReimbursed bool,
+	feeSponsorship types.FeeSponsorship,
 	currency currencytypes.CurrencyID,
 ) UpdatePolicyFact {
 	bf := base.NewBaseFact(UpdatePolicyFactHint, token)
@@ -71,6 +99,20 @@ func NewUpdatePolicyFact(
 		postSnapshotPeriod:   postSnapshotPeriod,
 		turnout:              turnout,
 		quorum:               quorum,
+		allowVoteChange:      allowVoteChange,
+		votingWeightMode:     votingWeightMode,
+		escrowDeposit:        escrowDeposit,
+		guardians:            guardians,
+		periodUnit:           periodUnit,
+		approvalThresholds:   approvalThresholds,
+		abstainMode:          abstainMode,
+		approvalBase:         approvalBase,
+		antiSnipingWindow:    antiSnipingWindow,
+		antiSnipingExtension: antiSnipingExtension,
+		revealPeriod:         revealPeriod,
+		tallyCommittee:       tallyCommittee,
+		relayFeeReimbursed:   relayFeeReimbursed,
+		feeSponsorship:       feeSponsorship,
 		currency:             currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -104,10 +146,33 @@ func (fact UpdatePolicyFact) Bytes() []byte {
 		util.Uint64ToBytes(fact.executionDelayPeriod),
 		fact.turnout.Bytes(),
 		fact.quorum.Bytes(),
+		fact.policy().ExtensionBytes(),
 		fact.currency.Bytes(),
 	)
 }
 
+func (fact UpdatePolicyFact) policy() types.Policy {
+	return types.NewPolicy(
+		fact.votingPowerToken, fact.threshold, fact.fee, fact.whitelist,
+		fact.proposalReviewPeriod, fact.registrationPeriod, fact.preSnapshotPeriod, fact.votingPeriod,
+		fact.postSnapshotPeriod, fact.executionDelayPeriod, fact.turnout, fact.quorum,
+		fact.allowVoteChange,
+		fact.votingWeightMode,
+		fact.escrowDeposit,
+		fact.guardians,
+		fact.periodUnit,
+		fact.approvalThresholds,
+		fact.abstainMode,
+		fact.approvalBase,
+		fact.antiSnipingWindow,
+		fact.antiSnipingExtension,
+		fact.revealPeriod,
+		fact.tallyCommittee,
+		fact.relayFeeReimbursed,
+		fact.feeSponsorship,
+	)
+}
+
 func (fact UpdatePolicyFact) IsValid(b []byte) error {
 	if err := fact.BaseHinter.IsValid(nil); err != nil {
 		return err
@@ -122,6 +187,14 @@ func (fact UpdatePolicyFact) IsValid(b []byte) error {
 		fact.whitelist,
 		fact.turnout,
 		fact.quorum,
+		fact.votingWeightMode,
+		fact.guardians,
+		fact.periodUnit,
+		fact.approvalThresholds,
+		fact.tallyCommittee,
+		fact.feeSponsorship,
+		fact.abstainMode,
+		fact.approvalBase,
 		fact.currency,
 	); err != nil {
 		return err
@@ -202,6 +275,62 @@ func (fact UpdatePolicyFact) Quorum() types.PercentRatio {
 	return fact.quorum
 }
 
+func (fact UpdatePolicyFact) AllowVoteChange() bool {
+	return fact.allowVoteChange
+}
+
+func (fact UpdatePolicyFact) VotingWeightMode() types.VotingWeightMode {
+	return fact.votingWeightMode
+}
+
+func (fact UpdatePolicyFact) EscrowDeposit() bool {
+	return fact.escrowDeposit
+}
+
+func (fact UpdatePolicyFact) Guardians() types.GuardianSet {
+	return fact.guardians
+}
+
+func (fact UpdatePolicyFact) PeriodUnit() types.PeriodUnit {
+	return fact.periodUnit
+}
+
+func (fact UpdatePolicyFact) ApprovalThresholds() types.ApprovalThresholds {
+	return fact.approvalThresholds
+}
+
+func (fact UpdatePolicyFact) AbstainMode() types.AbstainMode {
+	return fact.abstainMode
+}
+
+func (fact UpdatePolicyFact) ApprovalBase() types.ApprovalBase {
+	return fact.approvalBase
+}
+
+func (fact UpdatePolicyFact) AntiSnipingWindow() uint64 {
+	return fact.antiSnipingWindow
+}
+
+func (fact UpdatePolicyFact) AntiSnipingExtension() uint64 {
+	return fact.antiSnipingExtension
+}
+
+func (fact UpdatePolicyFact) RevealPeriod() uint64 {
+	return fact.revealPeriod
+}
+
+func (fact UpdatePolicyFact) TallyCommittee() types.TallyCommittee {
+	return fact.tallyCommittee
+}
+
+func (fact UpdatePolicyFact) RelayFeeReimbursed() bool {
+	return fact.relayFeeReimbursed
+}
+
+func (fact UpdatePolicyFact) FeeSponsorship() types.FeeSponsorship {
+	return fact.feeSponsorship
+}
+
 func (fact UpdatePolicyFact) Currency() currencytypes.CurrencyID {
 	return fact.currency
 }
@@ -15,6 +15,20 @@ func (fact *UpdatePolicyFact) unpack(enc encoder.Encoder,
 	bf, bw []byte,
 	prp, rp, prsp, vp, psp, edp uint64,
 	to, qou uint,
+	avc bool,
+	vwm string,
+	ed bool,
+	bg []byte,
+	pu string,
+	bat []byte,
+	am string,
+	ab string,
+	asw uint64,
+	ase uint64,
+	rvp uint64,
+	btc []byte,
+	rfr bool,
+	bfs []byte,
 	cid string,
 ) error {
 	e := util.StringError("failed to unmarshal UpdatePolicyFact")
@@ -30,6 +44,28 @@ func (fact *UpdatePolicyFact) unpack(enc encoder.Encoder,
 	fact.executionDelayPeriod = edp
 	fact.turnout = types.PercentRatio(to)
 	fact.quorum = types.PercentRatio(qou)
+	fact.allowVoteChange = avc
+	fact.votingWeightMode = types.VotingWeightMode(vwm)
+	if len(vwm) < 1 { // facts without voting weight mode weigh voting power linearly
+		fact.votingWeightMode = types.VotingWeightLinear
+	}
+	fact.escrowDeposit = ed
+	fact.periodUnit = types.PeriodUnit(pu)
+	if len(pu) < 1 { // facts without period unit count periods in seconds
+		fact.periodUnit = types.PeriodUnitSecond
+	}
+	fact.abstainMode = types.AbstainMode(am)
+	if len(am) < 1 { // facts without abstain mode count abstaining votes toward the quorum
+		fact.abstainMode = types.AbstainCountQuorum
+	}
+	fact.approvalBase = types.ApprovalBase(ab)
+	if len(ab) < 1 { // facts without approval base measure approval against for and against
+		fact.approvalBase = types.ApprovalBaseForAgainst
+	}
+	fact.antiSnipingWindow = asw
+	fact.antiSnipingExtension = ase
+	fact.revealPeriod = rvp
+	fact.relayFeeReimbursed = rfr
 
 	if big, err := common.NewBigFromString(th); err != nil {
 		return e.Wrap(err)
@@ -67,5 +103,61 @@ func (fact *UpdatePolicyFact) unpack(enc encoder.Encoder,
 		fact.whitelist = wl
 	}
 
+	// facts without guardian set are decoded with empty guardian set
+	switch hinter, err := enc.Decode(bg); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		fact.guardians = types.NewGuardianSet(nil, 0)
+	default:
+		gs, ok := hinter.(types.GuardianSet)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected GuardianSet, not %T", hinter))
+		}
+		fact.guardians = gs
+	}
+
+	// facts without approval thresholds are decoded with empty thresholds
+	switch hinter, err := enc.Decode(bat); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		fact.approvalThresholds = types.NewApprovalThresholds(nil)
+	default:
+		at, ok := hinter.(types.ApprovalThresholds)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected ApprovalThresholds, not %T", hinter))
+		}
+		fact.approvalThresholds = at
+	}
+
+	// facts without tally committee are decoded with empty committee
+	switch hinter, err := enc.Decode(btc); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		fact.tallyCommittee = types.NewTallyCommittee(nil, nil, "", 0)
+	default:
+		tc, ok := hinter.(types.TallyCommittee)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected TallyCommittee, not %T", hinter))
+		}
+		fact.tallyCommittee = tc
+	}
+
+	// facts without fee sponsorship are decoded with inactive sponsorship
+	switch hinter, err := enc.Decode(bfs); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		fact.feeSponsorship = types.NewFeeSponsorship("", common.ZeroBig, common.ZeroBig)
+	default:
+		fs, ok := hinter.(types.FeeSponsorship)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected FeeSponsorship, not %T", hinter))
+		}
+		fact.feeSponsorship = fs
+	}
+
 	return nil
 }
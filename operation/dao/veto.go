@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	VetoFactHint = hint.MustNewHint("mitum-dao-veto-operation-fact-v0.0.1")
+	VetoHint     = hint.MustNewHint("mitum-dao-veto-operation-v0.0.1")
+)
+
+type VetoFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	proposalID string
+	currency   currencytypes.CurrencyID
+}
+
+func NewVetoFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	proposalID string,
+	currency currencytypes.CurrencyID,
+) VetoFact {
+	bf := base.NewBaseFact(VetoFactHint, token)
+	fact := VetoFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		proposalID: proposalID,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact VetoFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact VetoFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact VetoFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.proposalID),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact VetoFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if len(fact.proposalID) == 0 {
+		return util.ErrInvalid.Errorf("empty propose id")
+	}
+
+	if !currencytypes.ReSpcecialChar.Match([]byte(fact.proposalID)) {
+		return util.ErrInvalid.Errorf("invalid proposalID due to the inclusion of special characters")
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact VetoFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact VetoFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact VetoFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact VetoFact) ProposalID() string {
+	return fact.proposalID
+}
+
+func (fact VetoFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact VetoFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+
+	as[0] = fact.sender
+	as[1] = fact.contract
+
+	return as, nil
+}
+
+type Veto struct {
+	common.BaseOperation
+}
+
+func NewVeto(fact VetoFact) (Veto, error) {
+	return Veto{BaseOperation: common.NewBaseOperation(VetoHint, fact)}, nil
+}
+
+func (op *Veto) HashSign(priv base.Privatekey, networkID base.NetworkID) error {
+	err := op.Sign(priv, networkID)
+	if err != nil {
+		return err
+	}
+	return nil
+}
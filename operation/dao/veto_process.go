@@ -0,0 +1,388 @@
+package dao
+
+import (
+	"context"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-currency/v3/operation/processor"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/state"
+	"github.com/ProtoconNet/mitum-dao/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var vetoProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(VetoProcessor)
+	},
+}
+
+func (Veto) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type VetoProcessor struct {
+	*base.BaseOperationProcessor
+	getLastBlockFunc processor.GetLastBlockFunc
+}
+
+func NewVetoProcessor(getLastBlockFunc processor.GetLastBlockFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new VetoProcessor")
+
+		nopp := vetoProcessorPool.Get()
+		opp, ok := nopp.(*VetoProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected VetoProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.getLastBlockFunc = getLastBlockFunc
+
+		return opp, nil
+	}
+}
+
+func (opp *VetoProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess Veto")
+
+	fact, ok := op.Fact().(VetoFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not VetoFact, %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender not found, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender cannot be a contract account, %s: %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao contract account not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("fee currency doesn't exist, %q: %w", fact.Currency(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "key of proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s,%q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	if p.Status() == types.Vetoed {
+		return nil, base.NewBaseOperationProcessReasonError("already vetoed proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	} else if p.Status() != types.Completed {
+		return nil, base.NewBaseOperationProcessReasonError("only completed proposal can be vetoed, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	if !design.Policy().Guardians().IsGuardian(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not guardian of dao, %s, %q", fact.Contract(), fact.Sender()), nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyVetoes(fact.Contract(), fact.ProposalID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to find vetoes state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	case found:
+		vetoes, err := state.StateVetoesValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find vetoes value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+
+		for _, r := range vetoes {
+			if r.Guardian().Equal(fact.Sender()) {
+				return nil, base.NewBaseOperationProcessReasonError("already vetoed by guardian, %s, %q, %q", fact.Contract(), fact.ProposalID(), fact.Sender()), nil
+			}
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing: %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *VetoProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process Veto")
+
+	fact, ok := op.Fact().(VetoFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected VetoFact, not %T", op.Fact())
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), "key of proposal", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal not found, %s,%q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	p, err := state.StateProposalValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("proposal value not found from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	}
+
+	blockMap, found, err := opp.getLastBlockFunc()
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("get LastBlock failed: %w", err), nil
+	} else if !found {
+		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
+	}
+
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.ExecutionDelay, blockMap)
+	if period != types.ExecutionDelay {
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within the ExecutionDelay period; ExecutionDelay period(%d, %d), now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("dao design value not found, %s: %w", fact.Contract(), err), nil
+	}
+
+	var sts []base.StateMergeValue
+
+	{ // caculate operation fee
+		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("currency not found, %q; %w", fact.Currency(), err), nil
+		}
+
+		fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to check fee of currency, %q; %w",
+				fact.Currency(),
+				err,
+			), nil
+		}
+
+		senderBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+			"key of sender balance",
+			getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"sender balance not found, %q; %w",
+				fact.Sender(),
+				err,
+			), nil
+		}
+
+		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to get balance value, %q; %w",
+				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				err,
+			), nil
+		case senderBal.Big().Compare(fee) < 0:
+			return nil, base.NewBaseOperationProcessReasonError(
+				"not enough balance of sender, %q",
+				fact.Sender(),
+			), nil
+		}
+
+		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		if !ok {
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+		}
+
+		if currencyPolicy.Feeer().Receiver() != nil {
+			if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
+				return nil, nil, err
+			} else if feeRcvrSt, found, err := getStateFunc(currency.StateKeyBalance(currencyPolicy.Feeer().Receiver(), fact.currency)); err != nil {
+				return nil, nil, err
+			} else if !found {
+				return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
+			} else if feeRcvrSt.Key() != senderBalSt.Key() {
+				r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
+				if !ok {
+					return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
+				}
+				sts = append(sts, common.NewBaseStateMergeValue(
+					feeRcvrSt.Key(),
+					currency.NewAddBalanceStateValue(r.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, feeRcvrSt.Key(), fact.currency, st)
+					},
+				))
+
+				sts = append(sts, common.NewBaseStateMergeValue(
+					senderBalSt.Key(),
+					currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
+					func(height base.Height, st base.State) base.StateValueMerger {
+						return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+					},
+				))
+			}
+		}
+	}
+
+	var vetoes []types.VetoRecord
+	switch st, found, err := getStateFunc(state.StateKeyVetoes(fact.Contract(), fact.ProposalID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to find vetoes state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+	case found:
+		vetoes, err = state.StateVetoesValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to find vetoes value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+	}
+
+	sts = append(sts, common.NewBaseStateMergeValue(
+		state.StateKeyVetoes(fact.Contract(), fact.ProposalID()),
+		state.NewVetoesStateValue([]types.VetoRecord{types.NewVetoRecord(fact.Sender(), p.Policy().PeriodUnit().Now(blockMap))}),
+		func(height base.Height, st base.State) base.StateValueMerger {
+			return state.NewVetoesStateValueMerger(height, state.StateKeyVetoes(fact.Contract(), fact.ProposalID()), st)
+		},
+	))
+
+	// the vetoes cast together in a block reaching the threshold only when
+	// merged veto the proposal at the execute, see vetoesReached
+	if uint(len(vetoes))+1 >= design.Policy().Guardians().Threshold() {
+		vsts, err := vetoProposal(fact.Contract(), fact.ProposalID(), p, opp.Height(), getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+		sts = append(sts, vsts...)
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *VetoProcessor) Close() error {
+	vetoProcessorPool.Put(opp)
+
+	return nil
+}
+
+// vetoesReached checks the vetoes of the proposal reach the threshold of the
+// guardians of the dao.
+func vetoesReached(contract base.Address, proposalID string, getStateFunc base.GetStateFunc) (bool, error) {
+	st, err := currencystate.ExistsState(state.StateKeyDesign(contract), "key of design", getStateFunc)
+	if err != nil {
+		return false, errors.Errorf("dao design not found, %s: %v", contract, err)
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return false, errors.Errorf("dao design value not found, %s: %v", contract, err)
+	}
+
+	if !design.Policy().Guardians().Active() {
+		return false, nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyVetoes(contract, proposalID)); {
+	case err != nil:
+		return false, errors.Errorf("failed to find vetoes state, %s, %q: %v", contract, proposalID, err)
+	case !found:
+		return false, nil
+	default:
+		vetoes, err := state.StateVetoesValue(st)
+		if err != nil {
+			return false, errors.Errorf("failed to find vetoes value from state, %s, %q: %v", contract, proposalID, err)
+		}
+
+		return uint(len(vetoes)) >= design.Policy().Guardians().Threshold(), nil
+	}
+}
+
+// vetoProposal returns the state merges vetoing the completed proposal. The
+// vetoed result keeps the figures of the tally without the winner.
+func vetoProposal(
+	contract base.Address, proposalID string, p state.ProposalStateValue, height base.Height, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	result := types.NewProposalResult(
+		types.Vetoed,
+		common.ZeroBig, common.ZeroBig, common.ZeroBig,
+		common.ZeroBig, common.ZeroBig, common.ZeroBig,
+		map[uint8]common.Big{},
+		0,
+		false,
+		types.ApprovalRuleVeto,
+		height,
+	)
+
+	switch st, found, err := getStateFunc(state.StateKeyProposalResult(contract, proposalID)); {
+	case err != nil:
+		return nil, errors.Errorf("failed to find proposal result state, %s, %q: %v", contract, proposalID, err)
+	case found:
+		tr, err := state.StateProposalResultValue(st)
+		if err != nil {
+			return nil, errors.Errorf("failed to find proposal result value from state, %s, %q: %v", contract, proposalID, err)
+		}
+
+		result = types.NewProposalResult(
+			types.Vetoed,
+			tr.TotalSupply(), tr.Turnout(), tr.TurnoutThreshold(),
+			tr.VotedTotal(), tr.QuorumTotal(), tr.QuorumThreshold(),
+			tr.OptionTotals(),
+			0,
+			false,
+			types.ApprovalRuleVeto,
+			height,
+		)
+	}
+
+	return []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposal(contract, proposalID),
+			state.NewProposalStateValue(types.Vetoed, p.Proposal(), p.Policy(), p.VotingExtension()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyProposalResult(contract, proposalID),
+			state.NewProposalResultStateValue(result),
+		),
+	}, nil
+}
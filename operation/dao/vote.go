@@ -3,6 +3,7 @@ package dao
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -20,6 +21,7 @@ type VoteFact struct {
 	contract   base.Address
 	proposalID string
 	vote       uint8
+	ballot     types.Ballot
 	currency   currencytypes.CurrencyID
 }
 
@@ -29,6 +31,7 @@ func NewVoteFact(
 	contract base.Address,
 	proposalID string,
 	vote uint8,
+	ballot types.Ballot,
 	currency currencytypes.CurrencyID,
 ) VoteFact {
 	bf := base.NewBaseFact(VoteFactHint, token)
@@ -38,6 +41,7 @@ func NewVoteFact(
 		contract:   contract,
 		proposalID: proposalID,
 		vote:       vote,
+		ballot:     ballot,
 		currency:   currency,
 	}
 	fact.SetHash(fact.GenerateHash())
@@ -60,6 +64,7 @@ func (fact VoteFact) Bytes() []byte {
 		fact.contract.Bytes(),
 		[]byte(fact.proposalID),
 		util.Uint8ToBytes(fact.vote),
+		fact.ballot.Bytes(),
 		fact.currency.Bytes(),
 	)
 }
@@ -73,6 +78,7 @@ func (fact VoteFact) IsValid(b []byte) error {
 		fact.sender,
 		fact.contract,
 		fact.currency,
+		fact.ballot,
 	); err != nil {
 		return err
 	}
@@ -116,6 +122,12 @@ func (fact VoteFact) Vote() uint8 {
 	return fact.vote
 }
 
+// Ballot returns the options of an approval or a ranked-choice vote;
+// the vote option is not used when the ballot is given.
+func (fact VoteFact) Ballot() types.Ballot {
+	return fact.ballot
+}
+
 func (fact VoteFact) Currency() currencytypes.CurrencyID {
 	return fact.currency
 }
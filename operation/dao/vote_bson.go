@@ -5,6 +5,7 @@ import (
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/ProtoconNet/mitum2/util/valuehash"
@@ -18,6 +19,7 @@ func (fact VoteFact) MarshalBSON() ([]byte, error) {
 			"contract":    fact.contract,
 			"proposal_id": fact.proposalID,
 			"vote":        fact.vote,
+			"ballot":      fact.ballot,
 			"currency":    fact.currency,
 			"hash":        fact.BaseFact.Hash().String(),
 			"token":       fact.BaseFact.Token(),
@@ -26,12 +28,13 @@ func (fact VoteFact) MarshalBSON() ([]byte, error) {
 }
 
 type VoteFactBSONUnmarshaler struct {
-	Hint       string `bson:"_hint"`
-	Sender     string `bson:"sender"`
-	Contract   string `bson:"contract"`
-	ProposalID string `bson:"proposal_id"`
-	Vote       uint8  `bson:"vote"`
-	Currency   string `bson:"currency"`
+	Hint       string       `bson:"_hint"`
+	Sender     string       `bson:"sender"`
+	Contract   string       `bson:"contract"`
+	ProposalID string       `bson:"proposal_id"`
+	Vote       uint8        `bson:"vote"`
+	Ballot     types.Ballot `bson:"ballot"`
+	Currency   string       `bson:"currency"`
 }
 
 func (fact *VoteFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -62,6 +65,7 @@ func (fact *VoteFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uf.Contract,
 		uf.ProposalID,
 		uf.Vote,
+		uf.Ballot,
 		uf.Currency,
 	)
 }
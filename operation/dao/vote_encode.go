@@ -2,18 +2,20 @@ package dao
 
 import (
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 )
 
 func (fact *VoteFact) unpack(enc encoder.Encoder,
-	sa, ca, pid string, vt uint8, cid string,
+	sa, ca, pid string, vt uint8, bl types.Ballot, cid string,
 ) error {
 	e := util.StringError("failed to unmarshal VoteFact")
 
 	fact.proposalID = pid
 	fact.vote = vt
+	fact.ballot = bl
 	fact.currency = currencytypes.CurrencyID(cid)
 
 	switch a, err := base.DecodeAddress(sa, enc); {
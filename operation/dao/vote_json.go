@@ -3,6 +3,7 @@ package dao
 import (
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
@@ -14,6 +15,7 @@ type VoteFactJSONMarshaler struct {
 	Contract   base.Address             `json:"contract"`
 	ProposalID string                   `json:"proposal_id"`
 	Vote       uint8                    `json:"vote"`
+	Ballot     types.Ballot             `json:"ballot,omitempty"`
 	Currency   currencytypes.CurrencyID `json:"currency"`
 }
 
@@ -24,17 +26,19 @@ func (fact VoteFact) MarshalJSON() ([]byte, error) {
 		Contract:              fact.contract,
 		ProposalID:            fact.proposalID,
 		Vote:                  fact.vote,
+		Ballot:                fact.ballot,
 		Currency:              fact.currency,
 	})
 }
 
 type VoteFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner      string `json:"sender"`
-	Contract   string `json:"contract"`
-	ProposalID string `json:"proposal_id"`
-	Vote       uint8  `json:"vote"`
-	Currency   string `json:"currency"`
+	Owner      string       `json:"sender"`
+	Contract   string       `json:"contract"`
+	ProposalID string       `json:"proposal_id"`
+	Vote       uint8        `json:"vote"`
+	Ballot     types.Ballot `json:"ballot"`
+	Currency   string       `json:"currency"`
 }
 
 func (fact *VoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -52,6 +56,7 @@ func (fact *VoteFact) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		uf.Contract,
 		uf.ProposalID,
 		uf.Vote,
+		uf.Ballot,
 		uf.Currency,
 	)
 }
@@ -1,6 +1,7 @@
 package dao
 
 import (
+	"bytes"
 	"context"
 	"sync"
 
@@ -110,48 +111,67 @@ func (opp *VoteProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("already canceled proposal, %s, %q", fact.Contract(), fact.ProposalID()), nil
 	}
 
-	if p.Status() != types.PreSnapped {
+	if p.Policy().CommitReveal() {
+		return nil, base.NewBaseOperationProcessReasonError("vote must be committed to proposal in commit-reveal voting, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	}
+
+	if p.Policy().EncryptedVoting() {
+		return nil, base.NewBaseOperationProcessReasonError("vote must be encrypted to proposal in encrypted voting, %s, %q", fact.Contract(), fact.ProposalID()), nil
+	}
+
+	// the first vote of a proposal not pre-snapped yet takes the pre snapshot
+	if p.Status() != types.PreSnapped && p.Status() != types.Proposed {
 		return nil, base.NewBaseOperationProcessReasonError("proposal not in pre-snapped status, %s, %q, %q", fact.Contract(), fact.ProposalID(), p.Status()), nil
 	}
 
-	switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case !found:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	default:
-		voters, err := state.StateVotersValue(st)
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voters value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-		}
+	if err := checkBallot(fact.Ballot(), p.Proposal()); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid ballot, sender(%s), %s, %q: %w", fact.Sender(), fact.Contract(), fact.ProposalID(), err), nil
+	}
 
-		for i, v := range voters {
-			if v.Account().Equal(fact.Sender()) {
-				break
+	if p.Status() == types.PreSnapped {
+		switch st, found, err := getStateFunc(state.StateKeyVoters(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case !found:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voters state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		default:
+			voters, err := state.StateVotersValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to find voters value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
 			}
 
-			if i == len(voters)-1 {
-				return nil, base.NewBaseOperationProcessReasonError("sender is not registered as voter, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			for i, v := range voters {
+				if v.Account().Equal(fact.Sender()) {
+					break
+				}
+
+				if i == len(voters)-1 {
+					return nil, base.NewBaseOperationProcessReasonError("sender is not registered as voter, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+				}
 			}
 		}
-	}
 
-	switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case found:
-		vpb, err := state.StateVotingPowerBoxValue(st)
-		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-		}
+		switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case found:
+			vpb, err := state.StateVotingPowerBoxValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			}
 
-		vp, found := vpb.VotingPowers()[fact.Sender().String()]
-		if !found {
-			return nil, base.NewBaseOperationProcessReasonError("sender voting power not found, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
-		}
+			vp, found := vpb.VotingPowers()[fact.Sender().String()]
+			if !found {
+				return nil, base.NewBaseOperationProcessReasonError("sender voting power not found, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			}
 
-		if vp.Voted() {
-			return nil, base.NewBaseOperationProcessReasonError("sender already voted, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			if vp.Voted() && !p.Policy().AllowVoteChange() {
+				return nil, base.NewBaseOperationProcessReasonError("sender already voted, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			} else if vp.Voted() && len(vp.Allocations()) < 1 && len(fact.Ballot()) < 1 && vp.VoteFor() == fact.Vote() {
+				return nil, base.NewBaseOperationProcessReasonError("sender already voted for the same option, sender(%s), %s, %q, %d", fact.Sender(), fact.Contract(), fact.ProposalID(), fact.Vote()), nil
+			} else if vp.Voted() && len(fact.Ballot()) > 0 && bytes.Equal(vp.Ballot().Bytes(), fact.Ballot().Bytes()) {
+				return nil, base.NewBaseOperationProcessReasonError("sender already cast the same ballot, sender(%s), %s, %q, %v", fact.Sender(), fact.Contract(), fact.ProposalID(), fact.Ballot()), nil
+			}
 		}
 	}
 
@@ -190,52 +210,109 @@ func (opp *VoteProcessor) Process(
 		return nil, base.NewBaseOperationProcessReasonError("LastBlock not found"), nil
 	}
 
-	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), types.Voting, blockMap)
+	period, start, end := types.GetPeriodOfCurrentTime(p.Policy(), p.Proposal(), p.VotingExtension(), types.Voting, blockMap)
 	if period != types.Voting {
-		return nil, base.NewBaseOperationProcessReasonError("current time is not within Voting period, Voting period; start(%d), end(%d), but now(%d)", start, end, blockMap.Manifest().ProposedAt().Unix()), nil
+		return nil, base.NewBaseOperationProcessReasonError("current time is not within Voting period, Voting period; start(%d), end(%d), but now(%d)", start, end, p.Policy().PeriodUnit().Now(blockMap)), nil
 	}
 
 	var sts []base.StateMergeValue
 
+	var snapshot preSnapshot
+	snapped := p.Status() == types.Proposed
+
 	var votingPowerBox types.VotingPowerBox
-	switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
-	case err != nil:
-		return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	case !found:
-		return nil, base.NewBaseOperationProcessReasonError("voting power box state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
-	default:
-		vpb, err := state.StateVotingPowerBoxValue(st)
+	if snapped {
+		s, err := takePreSnapshot(fact.Contract(), fact.ProposalID(), p, opp.Height(), getStateFunc)
 		if err != nil {
-			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			return nil, base.NewBaseOperationProcessReasonError("failed to take pre snapshot, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		}
+		snapshot = s
+		votingPowerBox = s.votingPowerBox
+	} else {
+		switch st, found, err := getStateFunc(state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID())); {
+		case err != nil:
+			return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		case !found:
+			return nil, base.NewBaseOperationProcessReasonError("voting power box state not found, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+		default:
+			vpb, err := state.StateVotingPowerBoxValue(st)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("failed to find voting power box value from state, %s, %q: %w", fact.Contract(), fact.ProposalID(), err), nil
+			}
+			votingPowerBox = vpb
 		}
-		votingPowerBox = vpb
 	}
 
-	vp, found := votingPowerBox.VotingPowers()[fact.Sender().String()]
-	if !found {
-		return nil, base.NewBaseOperationProcessReasonError("sender voting power not found, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
-	}
-	vp.SetVoted(true)
-	vp.SetVoteFor(fact.Vote())
+	if snapped && snapshot.canceled {
+		// canceled for low turnout, the vote is not counted
+		ssts, err := snapshot.states(fact.Contract(), fact.ProposalID(), p, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+		sts = append(sts, ssts...)
+	} else {
+		vp, found := votingPowerBox.VotingPowers()[fact.Sender().String()]
+		if !found {
+			return nil, base.NewBaseOperationProcessReasonError("sender voting power not found, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+		}
 
-	vpb := votingPowerBox.VotingPowers()
-	vpb[fact.Sender().String()] = vp
-	votingPowerBox.SetVotingPowers(vpb)
+		method := p.Proposal().VotingMethod()
+		leader, leading := votingPowerBox.Leader(p.Proposal().VoteOptionsCount() - 1)
 
-	result := votingPowerBox.Result()
-	if _, found := result[fact.Vote()]; found {
-		result[fact.Vote()] = result[fact.Vote()].Add(vp.Amount())
-	} else {
-		result[fact.Vote()] = common.ZeroBig.Add(vp.Amount())
-	}
-	votingPowerBox.SetResult(result)
+		result := votingPowerBox.Result()
+		if vp.Voted() {
+			if !p.Policy().AllowVoteChange() {
+				return nil, base.NewBaseOperationProcessReasonError("sender already voted, sender(%s), %s, %q", fact.Sender(), fact.Contract(), fact.ProposalID()), nil
+			}
+
+			for o, am := range vp.VotedWeights(method) {
+				if r, found := result[o]; found {
+					result[o] = r.Sub(am)
+				}
+			}
+		}
 
-	sts = append(sts,
-		currencystate.NewStateMergeValue(
-			state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
-			state.NewVotingPowerBoxStateValue(votingPowerBox),
-		),
-	)
+		vp.SetVoted(true)
+		vp.SetVoteFor(fact.Vote())
+		vp.SetAllocations(nil)
+		vp.SetBallot(fact.Ballot())
+
+		vpb := votingPowerBox.VotingPowers()
+		vpb[fact.Sender().String()] = vp
+		votingPowerBox.SetVotingPowers(vpb)
+
+		for o, am := range vp.VotedWeights(method) {
+			if _, found := result[o]; found {
+				result[o] = result[o].Add(am)
+			} else {
+				result[o] = common.ZeroBig.Add(am)
+			}
+		}
+		votingPowerBox.SetResult(result)
+
+		np, extended := extendVoting(p, leader, leading, votingPowerBox, blockMap)
+
+		if snapped {
+			snapshot.votingPowerBox = votingPowerBox
+
+			ssts, err := snapshot.states(fact.Contract(), fact.ProposalID(), np, getStateFunc)
+			if err != nil {
+				return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+			}
+			sts = append(sts, ssts...)
+		} else {
+			sts = append(sts,
+				currencystate.NewStateMergeValue(
+					state.StateKeyVotingPowerBox(fact.Contract(), fact.ProposalID()),
+					state.NewVotingPowerBoxStateValue(votingPowerBox),
+				),
+			)
+
+			if extended {
+				sts = append(sts, currencystate.NewStateMergeValue(state.StateKeyProposal(fact.Contract(), fact.ProposalID()), np))
+			}
+		}
+	}
 
 	{ // caculate operation fee
 		currencyPolicy, err := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
@@ -256,36 +333,44 @@ func (opp *VoteProcessor) Process(
 			), nil
 		}
 
-		senderBalSt, err := currencystate.ExistsState(
-			currency.StateKeyBalance(fact.Sender(), fact.Currency()),
-			"key of sender balance",
+		payer, sponsored, err := feePayer(
+			fact.Contract(), fact.ProposalID(), fact.Sender(), fact.Currency(), fee, currencyPolicy.Feeer().Receiver(), getStateFunc,
+		)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to check fee sponsorship; %w", err), nil
+		}
+		sts = append(sts, sponsored...)
+
+		payerBalSt, err := currencystate.ExistsState(
+			currency.StateKeyBalance(payer, fact.Currency()),
+			"key of payer balance",
 			getStateFunc,
 		)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
-				"sender balance not found, %q; %w",
-				fact.Sender(),
+				"payer balance not found, %q; %w",
+				payer,
 				err,
 			), nil
 		}
 
-		switch senderBal, err := currency.StateBalanceValue(senderBalSt); {
+		switch payerBal, err := currency.StateBalanceValue(payerBalSt); {
 		case err != nil:
 			return nil, base.NewBaseOperationProcessReasonError(
 				"failed to get balance value, %q; %w",
-				currency.StateKeyBalance(fact.Sender(), fact.Currency()),
+				currency.StateKeyBalance(payer, fact.Currency()),
 				err,
 			), nil
-		case senderBal.Big().Compare(fee) < 0:
+		case payerBal.Big().Compare(fee) < 0:
 			return nil, base.NewBaseOperationProcessReasonError(
-				"not enough balance of sender, %q",
-				fact.Sender(),
+				"not enough balance of payer, %q",
+				payer,
 			), nil
 		}
 
-		v, ok := senderBalSt.Value().(currency.BalanceStateValue)
+		v, ok := payerBalSt.Value().(currency.BalanceStateValue)
 		if !ok {
-			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", senderBalSt.Value()), nil
+			return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", payerBalSt.Value()), nil
 		}
 
 		if err := currencystate.CheckExistsState(currency.StateKeyAccount(currencyPolicy.Feeer().Receiver()), getStateFunc); err != nil {
@@ -294,7 +379,7 @@ func (opp *VoteProcessor) Process(
 			return nil, nil, err
 		} else if !found {
 			return nil, nil, errors.Errorf("feeer receiver %s not found", currencyPolicy.Feeer().Receiver())
-		} else if feeRcvrSt.Key() != senderBalSt.Key() {
+		} else if feeRcvrSt.Key() != payerBalSt.Key() {
 			r, ok := feeRcvrSt.Value().(currency.BalanceStateValue)
 			if !ok {
 				return nil, nil, errors.Errorf("expected %T, not %T", currency.BalanceStateValue{}, feeRcvrSt.Value())
@@ -308,10 +393,10 @@ func (opp *VoteProcessor) Process(
 			))
 
 			sts = append(sts, common.NewBaseStateMergeValue(
-				senderBalSt.Key(),
+				payerBalSt.Key(),
 				currency.NewDeductBalanceStateValue(v.Amount.WithBig(fee)),
 				func(height base.Height, st base.State) base.StateValueMerger {
-					return currency.NewBalanceStateValueMerger(height, senderBalSt.Key(), fact.currency, st)
+					return currency.NewBalanceStateValueMerger(height, payerBalSt.Key(), fact.currency, st)
 				},
 			))
 		}
@@ -325,3 +410,58 @@ func (opp *VoteProcessor) Close() error {
 
 	return nil
 }
+
+// checkBallot checks the ballot shape against the voting method of the proposal.
+// Approval and ranked-choice votes need a ballot of the proposal options and the
+// last option, abstention, can only be cast alone.
+func checkBallot(ballot types.Ballot, proposal types.Proposal) error {
+	method := proposal.VotingMethod()
+
+	if method == types.VotingPlurality {
+		if len(ballot) > 0 {
+			return errors.Errorf("ballot not allowed for %s voting", method)
+		}
+
+		return nil
+	}
+
+	if len(ballot) < 1 {
+		return errors.Errorf("empty ballot for %s voting", method)
+	}
+
+	abstain := proposal.VoteOptionsCount() - 1
+	for _, o := range ballot {
+		if proposal.VoteOptionsCount() <= o {
+			return errors.Errorf("option out of range, %d >= %d", o, proposal.VoteOptionsCount())
+		}
+
+		if o == abstain && len(ballot) > 1 {
+			return errors.Errorf("abstention must be cast alone")
+		}
+	}
+
+	return nil
+}
+
+// extendVoting returns the proposal with the voting period extended by the
+// anti-sniping extension of the policy when the leading option is flipped in
+// the anti-sniping window, the last part of the voting period.
+func extendVoting(
+	p state.ProposalStateValue, leader uint8, leading bool, votingPowerBox types.VotingPowerBox, blockMap base.BlockMap,
+) (state.ProposalStateValue, bool) {
+	window, extension := p.Policy().AntiSnipingWindow(), p.Policy().AntiSnipingExtension()
+	if window < 1 || extension < 1 {
+		return p, false
+	}
+
+	if l, found := votingPowerBox.Leader(p.Proposal().VoteOptionsCount() - 1); found == leading && (!found || l == leader) {
+		return p, false
+	}
+
+	_, end := types.GetPeriodBounds(p.Policy(), p.Proposal(), p.VotingExtension(), types.Voting)
+	if p.Policy().PeriodUnit().Now(blockMap)+window < uint64(end) {
+		return p, false
+	}
+
+	return state.NewProposalStateValue(p.Status(), p.Proposal(), p.Policy(), p.VotingExtension()+extension), true
+}
@@ -80,6 +80,30 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op mitumbase.Op
 			return errors.Errorf("expected WithdrawFact, not %T", t.Fact())
 		}
 		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case dao.Lock:
+		fact, ok := t.Fact().(dao.LockFact)
+		if !ok {
+			return errors.Errorf("expected LockFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case dao.Unlock:
+		fact, ok := t.Fact().(dao.UnlockFact)
+		if !ok {
+			return errors.Errorf("expected UnlockFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case dao.Register:
+		fact, ok := t.Fact().(dao.RegisterFact)
+		if !ok {
+			return errors.Errorf("expected RegisterFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
+	case dao.Unregister:
+		fact, ok := t.Fact().(dao.UnregisterFact)
+		if !ok {
+			return errors.Errorf("expected UnregisterFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = currencyprocessor.DuplicationKey(fact.Sender().String(), DuplicationTypeSender)
 	default:
 		return nil
 	}
@@ -147,7 +171,23 @@ func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op mitumbase.Ope
 		dao.PreSnap,
 		dao.Vote,
 		dao.PostSnap,
-		dao.Execute:
+		dao.Execute,
+		dao.Lock,
+		dao.Unlock,
+		dao.Delegate,
+		dao.Undelegate,
+		dao.Unregister,
+		dao.Redelegate,
+		dao.RevokeVote,
+		dao.SplitVote,
+		dao.Approve,
+		dao.Veto,
+		dao.CommitVote,
+		dao.RevealVote,
+		dao.SubmitEncryptedVote,
+		dao.SubmitDecryptionShare,
+		dao.RelayVotes,
+		dao.RegisterItems:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
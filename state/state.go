@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -84,17 +86,21 @@ var (
 
 type ProposalStateValue struct {
 	hint.BaseHinter
-	status   types.ProposalStatus
-	proposal types.Proposal
-	policy   types.Policy
+	status          types.ProposalStatus
+	proposal        types.Proposal
+	policy          types.Policy
+	votingExtension uint64
 }
 
-func NewProposalStateValue(status types.ProposalStatus, proposal types.Proposal, policy types.Policy) ProposalStateValue {
+func NewProposalStateValue(
+	status types.ProposalStatus, proposal types.Proposal, policy types.Policy, votingExtension uint64,
+) ProposalStateValue {
 	return ProposalStateValue{
-		BaseHinter: hint.NewBaseHinter(ProposalStateValueHint),
-		status:     status,
-		proposal:   proposal,
-		policy:     policy,
+		BaseHinter:      hint.NewBaseHinter(ProposalStateValueHint),
+		status:          status,
+		proposal:        proposal,
+		policy:          policy,
+		votingExtension: votingExtension,
 	}
 }
 
@@ -114,6 +120,12 @@ func (p ProposalStateValue) Policy() types.Policy {
 	return p.policy
 }
 
+// VotingExtension returns the sum of the anti-sniping extensions of the voting
+// period; the periods after the voting period are delayed by it.
+func (p ProposalStateValue) VotingExtension() uint64 {
+	return p.votingExtension
+}
+
 func (p ProposalStateValue) IsValid([]byte) error {
 	e := util.ErrInvalid.Errorf("invalid dao ProposalStateValue")
 
@@ -159,8 +171,9 @@ func StateKeyProposal(ca base.Address, pid string) string {
 }
 
 var (
-	DelegatorsStateValueHint = hint.MustNewHint("mitum-dao-delegators-state-value-v0.0.1")
-	DelegatorsSuffix         = "delegators"
+	DelegatorsStateValueHint       = hint.MustNewHint("mitum-dao-delegators-state-value-v0.0.1")
+	RemoveDelegatorsStateValueHint = hint.MustNewHint("mitum-dao-remove-delegators-state-value-v0.0.1")
+	DelegatorsSuffix               = "delegators"
 )
 
 type DelegatorsStateValue struct {
@@ -227,9 +240,54 @@ func StateKeyDelegators(ca base.Address, pid string) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, DelegatorsSuffix)
 }
 
+// RemoveDelegatorsStateValue is only used to merge the removal of
+// delegators into DelegatorsStateValue; it is never stored.
+type RemoveDelegatorsStateValue struct {
+	hint.BaseHinter
+	accounts []base.Address
+}
+
+func NewRemoveDelegatorsStateValue(accounts []base.Address) RemoveDelegatorsStateValue {
+	return RemoveDelegatorsStateValue{
+		BaseHinter: hint.NewBaseHinter(RemoveDelegatorsStateValueHint),
+		accounts:   accounts,
+	}
+}
+
+func (dg RemoveDelegatorsStateValue) Hint() hint.Hint {
+	return dg.BaseHinter.Hint()
+}
+
+func (dg RemoveDelegatorsStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao RemoveDelegatorsStateValue")
+
+	if err := dg.BaseHinter.IsValid(RemoveDelegatorsStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, ac := range dg.accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (dg RemoveDelegatorsStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(dg.accounts))
+
+	for i, ac := range dg.accounts {
+		ba[i] = ac.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
 var (
-	VotersStateValueHint = hint.MustNewHint("mitum-dao-voters-state-value-v0.0.1")
-	VotersSuffix         = "voters"
+	VotersStateValueHint       = hint.MustNewHint("mitum-dao-voters-state-value-v0.0.1")
+	RemoveVotersStateValueHint = hint.MustNewHint("mitum-dao-remove-voters-state-value-v0.0.1")
+	VotersSuffix               = "voters"
 )
 
 type VotersStateValue struct {
@@ -305,6 +363,50 @@ func StateKeyVoters(ca base.Address, pid string) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VotersSuffix)
 }
 
+// RemoveVotersStateValue is only used to merge the removal of delegators
+// from the voters into VotersStateValue; it is never stored.
+type RemoveVotersStateValue struct {
+	hint.BaseHinter
+	voters []types.VoterInfo
+}
+
+func NewRemoveVotersStateValue(voters []types.VoterInfo) RemoveVotersStateValue {
+	return RemoveVotersStateValue{
+		BaseHinter: hint.NewBaseHinter(RemoveVotersStateValueHint),
+		voters:     voters,
+	}
+}
+
+func (vt RemoveVotersStateValue) Hint() hint.Hint {
+	return vt.BaseHinter.Hint()
+}
+
+func (vt RemoveVotersStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao RemoveVotersStateValue")
+
+	if err := vt.BaseHinter.IsValid(RemoveVotersStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, info := range vt.voters {
+		if err := info.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (vt RemoveVotersStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(vt.voters))
+
+	for i, br := range vt.voters {
+		bs[i] = br.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
 //var (
 //	ProposalStatusStateValueHint = hint.MustNewHint("mitum-dao-proposal-status-state-value-v0.0.1")
 //	ProposalStatusSuffix         = ":proposalstatus"
@@ -426,3 +528,718 @@ func IsStateVotingPowerBoxKey(key string) bool {
 func StateKeyVotingPowerBox(ca base.Address, pid string) string {
 	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VotingPowerBoxSuffix)
 }
+
+var (
+	LockStateValueHint       = hint.MustNewHint("mitum-dao-lock-state-value-v0.0.1")
+	RemoveLockStateValueHint = hint.MustNewHint("mitum-dao-remove-lock-state-value-v0.0.1")
+	LockSuffix               = "lock"
+)
+
+type LockStateValue struct {
+	hint.BaseHinter
+	lock types.LockInfo
+}
+
+func NewLockStateValue(lock types.LockInfo) LockStateValue {
+	return LockStateValue{
+		BaseHinter: hint.NewBaseHinter(LockStateValueHint),
+		lock:       lock,
+	}
+}
+
+func (l LockStateValue) Hint() hint.Hint {
+	return l.BaseHinter.Hint()
+}
+
+func (l LockStateValue) Lock() types.LockInfo {
+	return l.lock
+}
+
+func (l LockStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao LockStateValue")
+
+	if err := l.BaseHinter.IsValid(LockStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := l.lock.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (l LockStateValue) HashBytes() []byte {
+	return l.lock.Bytes()
+}
+
+// RemoveLockStateValue is only used to merge the deduction of the amount and
+// the removal of the proposals from the lock into LockStateValue; it is never
+// stored.
+type RemoveLockStateValue struct {
+	hint.BaseHinter
+	lock types.LockInfo
+}
+
+func NewRemoveLockStateValue(lock types.LockInfo) RemoveLockStateValue {
+	return RemoveLockStateValue{
+		BaseHinter: hint.NewBaseHinter(RemoveLockStateValueHint),
+		lock:       lock,
+	}
+}
+
+func (l RemoveLockStateValue) Hint() hint.Hint {
+	return l.BaseHinter.Hint()
+}
+
+func (l RemoveLockStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao RemoveLockStateValue")
+
+	if err := l.BaseHinter.IsValid(RemoveLockStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := l.lock.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (l RemoveLockStateValue) HashBytes() []byte {
+	return l.lock.Bytes()
+}
+
+func StateLockValue(st base.State) (types.LockInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return types.LockInfo{}, util.ErrNotFound.Errorf("lock not found in State")
+	}
+
+	l, ok := v.(LockStateValue)
+	if !ok {
+		return types.LockInfo{}, errors.Errorf("invalid lock value found, %T", v)
+	}
+
+	return l.lock, nil
+}
+
+func IsStateLockKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, LockSuffix)
+}
+
+func StateKeyLock(ca base.Address, account base.Address) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), account.String(), LockSuffix)
+}
+
+var (
+	AllowanceStateValueHint = hint.MustNewHint("mitum-dao-allowance-state-value-v0.0.1")
+	AllowanceSuffix         = "allowance"
+)
+
+type AllowanceStateValue struct {
+	hint.BaseHinter
+	allowance types.Allowance
+}
+
+func NewAllowanceStateValue(allowance types.Allowance) AllowanceStateValue {
+	return AllowanceStateValue{
+		BaseHinter: hint.NewBaseHinter(AllowanceStateValueHint),
+		allowance:  allowance,
+	}
+}
+
+func (a AllowanceStateValue) Hint() hint.Hint {
+	return a.BaseHinter.Hint()
+}
+
+func (a AllowanceStateValue) Allowance() types.Allowance {
+	return a.allowance
+}
+
+func (a AllowanceStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao AllowanceStateValue")
+
+	if err := a.BaseHinter.IsValid(AllowanceStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := a.allowance.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (a AllowanceStateValue) HashBytes() []byte {
+	return a.allowance.Bytes()
+}
+
+func StateAllowanceValue(st base.State) (types.Allowance, error) {
+	v := st.Value()
+	if v == nil {
+		return types.Allowance{}, util.ErrNotFound.Errorf("allowance not found in State")
+	}
+
+	a, ok := v.(AllowanceStateValue)
+	if !ok {
+		return types.Allowance{}, errors.Errorf("invalid allowance value found, %T", v)
+	}
+
+	return a.allowance, nil
+}
+
+func IsStateAllowanceKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, AllowanceSuffix)
+}
+
+func StateKeyAllowance(ca base.Address, account base.Address, cid currencytypes.CurrencyID) string {
+	return fmt.Sprintf("%s:%s:%s:%s", StateKeyDAOPrefix(ca), account.String(), cid.String(), AllowanceSuffix)
+}
+
+var (
+	DepositStateValueHint = hint.MustNewHint("mitum-dao-deposit-state-value-v0.0.1")
+	DepositSuffix         = "deposit"
+)
+
+type DepositStateValue struct {
+	hint.BaseHinter
+	deposit types.Deposit
+}
+
+func NewDepositStateValue(deposit types.Deposit) DepositStateValue {
+	return DepositStateValue{
+		BaseHinter: hint.NewBaseHinter(DepositStateValueHint),
+		deposit:    deposit,
+	}
+}
+
+func (d DepositStateValue) Hint() hint.Hint {
+	return d.BaseHinter.Hint()
+}
+
+func (d DepositStateValue) Deposit() types.Deposit {
+	return d.deposit
+}
+
+func (d DepositStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao DepositStateValue")
+
+	if err := d.BaseHinter.IsValid(DepositStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := d.deposit.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (d DepositStateValue) HashBytes() []byte {
+	return d.deposit.Bytes()
+}
+
+func StateDepositValue(st base.State) (types.Deposit, error) {
+	v := st.Value()
+	if v == nil {
+		return types.Deposit{}, util.ErrNotFound.Errorf("deposit not found in State")
+	}
+
+	d, ok := v.(DepositStateValue)
+	if !ok {
+		return types.Deposit{}, errors.Errorf("invalid deposit value found, %T", v)
+	}
+
+	return d.deposit, nil
+}
+
+func IsStateDepositKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DepositSuffix)
+}
+
+func StateKeyDeposit(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, DepositSuffix)
+}
+
+var (
+	VetoesStateValueHint = hint.MustNewHint("mitum-dao-vetoes-state-value-v0.0.1")
+	VetoesSuffix         = "vetoes"
+)
+
+type VetoesStateValue struct {
+	hint.BaseHinter
+	vetoes []types.VetoRecord
+}
+
+func NewVetoesStateValue(vetoes []types.VetoRecord) VetoesStateValue {
+	return VetoesStateValue{
+		BaseHinter: hint.NewBaseHinter(VetoesStateValueHint),
+		vetoes:     vetoes,
+	}
+}
+
+func (vt VetoesStateValue) Hint() hint.Hint {
+	return vt.BaseHinter.Hint()
+}
+
+func (vt VetoesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao VetoesStateValue")
+
+	if err := vt.BaseHinter.IsValid(VetoesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, r := range vt.vetoes {
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (vt VetoesStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(vt.vetoes))
+
+	for i, r := range vt.vetoes {
+		ba[i] = r.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+func StateVetoesValue(st base.State) ([]types.VetoRecord, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("vetoes not found in State")
+	}
+
+	vt, ok := v.(VetoesStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid vetoes value found, %T", v)
+	}
+
+	return vt.vetoes, nil
+}
+
+func IsStateVetoesKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, VetoesSuffix)
+}
+
+func StateKeyVetoes(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, VetoesSuffix)
+}
+
+var (
+	DecryptionSharesStateValueHint = hint.MustNewHint("mitum-dao-decryption-shares-state-value-v0.0.1")
+	DecryptionSharesSuffix         = "decryptionshares"
+)
+
+type DecryptionSharesStateValue struct {
+	hint.BaseHinter
+	shares []types.DecryptionShare
+}
+
+func NewDecryptionSharesStateValue(shares []types.DecryptionShare) DecryptionSharesStateValue {
+	return DecryptionSharesStateValue{
+		BaseHinter: hint.NewBaseHinter(DecryptionSharesStateValueHint),
+		shares:     shares,
+	}
+}
+
+func (ds DecryptionSharesStateValue) Hint() hint.Hint {
+	return ds.BaseHinter.Hint()
+}
+
+func (ds DecryptionSharesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao DecryptionSharesStateValue")
+
+	if err := ds.BaseHinter.IsValid(DecryptionSharesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, r := range ds.shares {
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (ds DecryptionSharesStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(ds.shares))
+
+	for i, r := range ds.shares {
+		ba[i] = r.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+func StateDecryptionSharesValue(st base.State) ([]types.DecryptionShare, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("decryption shares not found in State")
+	}
+
+	ds, ok := v.(DecryptionSharesStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid decryption shares value found, %T", v)
+	}
+
+	return ds.shares, nil
+}
+
+func IsStateDecryptionSharesKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DecryptionSharesSuffix)
+}
+
+func StateKeyDecryptionShares(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, DecryptionSharesSuffix)
+}
+
+var (
+	DelegationStateValueHint       = hint.MustNewHint("mitum-dao-delegation-state-value-v0.0.1")
+	RemoveDelegationStateValueHint = hint.MustNewHint("mitum-dao-remove-delegation-state-value-v0.0.1")
+	DelegationSuffix               = "delegation"
+)
+
+type DelegationStateValue struct {
+	hint.BaseHinter
+	delegations []types.DelegatorInfo
+}
+
+func NewDelegationStateValue(delegations []types.DelegatorInfo) DelegationStateValue {
+	return DelegationStateValue{
+		BaseHinter:  hint.NewBaseHinter(DelegationStateValueHint),
+		delegations: delegations,
+	}
+}
+
+func (dg DelegationStateValue) Hint() hint.Hint {
+	return dg.BaseHinter.Hint()
+}
+
+func (dg DelegationStateValue) Delegations() []types.DelegatorInfo {
+	return dg.delegations
+}
+
+func (dg DelegationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao DelegationStateValue")
+
+	if err := dg.BaseHinter.IsValid(DelegationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, info := range dg.delegations {
+		if err := info.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[info.Account().String()]; found {
+			return e.Wrap(errors.Errorf("duplicate delegator account found, %q", info.Account()))
+		}
+
+		founds[info.Account().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (dg DelegationStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(dg.delegations))
+
+	for i, info := range dg.delegations {
+		ba[i] = info.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+func StateDelegationValue(st base.State) ([]types.DelegatorInfo, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("delegation not found in State")
+	}
+
+	dg, ok := v.(DelegationStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid delegation value found, %T", v)
+	}
+
+	return dg.delegations, nil
+}
+
+func IsStateDelegationKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, DelegationSuffix)
+}
+
+func StateKeyDelegation(ca base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyDAOPrefix(ca), DelegationSuffix)
+}
+
+// RemoveDelegationStateValue is only used to merge the removal of
+// delegations into DelegationStateValue; it is never stored.
+type RemoveDelegationStateValue struct {
+	hint.BaseHinter
+	accounts []base.Address
+}
+
+func NewRemoveDelegationStateValue(accounts []base.Address) RemoveDelegationStateValue {
+	return RemoveDelegationStateValue{
+		BaseHinter: hint.NewBaseHinter(RemoveDelegationStateValueHint),
+		accounts:   accounts,
+	}
+}
+
+func (dg RemoveDelegationStateValue) Hint() hint.Hint {
+	return dg.BaseHinter.Hint()
+}
+
+func (dg RemoveDelegationStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao RemoveDelegationStateValue")
+
+	if err := dg.BaseHinter.IsValid(RemoveDelegationStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, ac := range dg.accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (dg RemoveDelegationStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(dg.accounts))
+
+	for i, ac := range dg.accounts {
+		ba[i] = ac.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+var (
+	SponsorshipStateValueHint = hint.MustNewHint("mitum-dao-sponsorship-state-value-v0.0.1")
+	SponsorshipSuffix         = "sponsorship"
+)
+
+// SponsorshipStateValue is the sum of the operation fees sponsored by the dao
+// treasury; it is merged by SponsorshipStateValueMerger with the fee of each
+// sponsored operation.
+type SponsorshipStateValue struct {
+	hint.BaseHinter
+	spent common.Big
+}
+
+func NewSponsorshipStateValue(spent common.Big) SponsorshipStateValue {
+	return SponsorshipStateValue{
+		BaseHinter: hint.NewBaseHinter(SponsorshipStateValueHint),
+		spent:      spent,
+	}
+}
+
+func (sp SponsorshipStateValue) Hint() hint.Hint {
+	return sp.BaseHinter.Hint()
+}
+
+func (sp SponsorshipStateValue) Spent() common.Big {
+	return sp.spent
+}
+
+func (sp SponsorshipStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao SponsorshipStateValue")
+
+	if err := sp.BaseHinter.IsValid(SponsorshipStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sp.spent.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if sp.spent.Compare(common.ZeroBig) < 0 {
+		return e.Wrap(errors.Errorf("negative sponsorship spent, %v", sp.spent))
+	}
+
+	return nil
+}
+
+func (sp SponsorshipStateValue) HashBytes() []byte {
+	return sp.spent.Bytes()
+}
+
+func StateSponsorshipValue(st base.State) (common.Big, error) {
+	v := st.Value()
+	if v == nil {
+		return common.Big{}, util.ErrNotFound.Errorf("sponsorship not found in State")
+	}
+
+	sp, ok := v.(SponsorshipStateValue)
+	if !ok {
+		return common.Big{}, errors.Errorf("invalid sponsorship value found, %T", v)
+	}
+
+	return sp.spent, nil
+}
+
+func IsStateSponsorshipKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, SponsorshipSuffix)
+}
+
+func StateKeySponsorship(ca base.Address) string {
+	return fmt.Sprintf("%s:%s", StateKeyDAOPrefix(ca), SponsorshipSuffix)
+}
+
+var (
+	SponsoredFeesStateValueHint = hint.MustNewHint("mitum-dao-sponsored-fees-state-value-v0.0.1")
+	SponsoredFeesSuffix         = "sponsoredfees"
+)
+
+// SponsoredFeesStateValue keeps the operation fees of each account sponsored
+// for a proposal; it is merged by SponsoredFeesStateValueMerger.
+type SponsoredFeesStateValue struct {
+	hint.BaseHinter
+	fees []types.SponsoredFee
+}
+
+func NewSponsoredFeesStateValue(fees []types.SponsoredFee) SponsoredFeesStateValue {
+	return SponsoredFeesStateValue{
+		BaseHinter: hint.NewBaseHinter(SponsoredFeesStateValueHint),
+		fees:       fees,
+	}
+}
+
+func (sf SponsoredFeesStateValue) Hint() hint.Hint {
+	return sf.BaseHinter.Hint()
+}
+
+func (sf SponsoredFeesStateValue) Fees() []types.SponsoredFee {
+	return sf.fees
+}
+
+func (sf SponsoredFeesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao SponsoredFeesStateValue")
+
+	if err := sf.BaseHinter.IsValid(SponsoredFeesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, fee := range sf.fees {
+		if err := fee.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[fee.Account().String()]; found {
+			return e.Wrap(errors.Errorf("duplicate sponsored account found, %q", fee.Account()))
+		}
+
+		founds[fee.Account().String()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (sf SponsoredFeesStateValue) HashBytes() []byte {
+	ba := make([][]byte, len(sf.fees))
+
+	for i, fee := range sf.fees {
+		ba[i] = fee.Bytes()
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+func StateSponsoredFeesValue(st base.State) ([]types.SponsoredFee, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("sponsored fees not found in State")
+	}
+
+	sf, ok := v.(SponsoredFeesStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid sponsored fees value found, %T", v)
+	}
+
+	return sf.fees, nil
+}
+
+func IsStateSponsoredFeesKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, SponsoredFeesSuffix)
+}
+
+func StateKeySponsoredFees(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, SponsoredFeesSuffix)
+}
+
+var (
+	ProposalResultStateValueHint = hint.MustNewHint("mitum-dao-proposal-result-state-value-v0.0.1")
+	ProposalResultSuffix         = "proposalresult"
+)
+
+type ProposalResultStateValue struct {
+	hint.BaseHinter
+	result types.ProposalResult
+}
+
+func NewProposalResultStateValue(result types.ProposalResult) ProposalResultStateValue {
+	return ProposalResultStateValue{
+		BaseHinter: hint.NewBaseHinter(ProposalResultStateValueHint),
+		result:     result,
+	}
+}
+
+func (r ProposalResultStateValue) Hint() hint.Hint {
+	return r.BaseHinter.Hint()
+}
+
+func (r ProposalResultStateValue) Result() types.ProposalResult {
+	return r.result
+}
+
+func (r ProposalResultStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid dao ProposalResultStateValue")
+
+	if err := r.BaseHinter.IsValid(ProposalResultStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.result.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r ProposalResultStateValue) HashBytes() []byte {
+	return r.result.Bytes()
+}
+
+func StateProposalResultValue(st base.State) (types.ProposalResult, error) {
+	v := st.Value()
+	if v == nil {
+		return types.ProposalResult{}, util.ErrNotFound.Errorf("proposal result not found in State")
+	}
+
+	r, ok := v.(ProposalResultStateValue)
+	if !ok {
+		return types.ProposalResult{}, errors.Errorf("invalid proposal result value found, %T", v)
+	}
+
+	return r.result, nil
+}
+
+func IsStateProposalResultKey(key string) bool {
+	return strings.HasPrefix(key, DAOPrefix) && strings.HasSuffix(key, ProposalResultSuffix)
+}
+
+func StateKeyProposalResult(ca base.Address, pid string) string {
+	return fmt.Sprintf("%s:%s:%s", StateKeyDAOPrefix(ca), pid, ProposalResultSuffix)
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/util"
@@ -55,19 +56,21 @@ func (de *DesignStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 func (p ProposalStateValue) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
-			"_hint":    p.Hint().String(),
-			"status":   p.status,
-			"proposal": p.proposal,
-			"policy":   p.policy,
+			"_hint":            p.Hint().String(),
+			"status":           p.status,
+			"proposal":         p.proposal,
+			"policy":           p.policy,
+			"voting_extension": p.votingExtension,
 		},
 	)
 }
 
 type ProposalStateValueBSONUnmarshaler struct {
-	Hint     string   `bson:"_hint"`
-	Status   uint8    `bson:"status"`
-	Proposal bson.Raw `bson:"proposal"`
-	Policy   bson.Raw `bson:"policy"`
+	Hint            string   `bson:"_hint"`
+	Status          uint8    `bson:"status"`
+	Proposal        bson.Raw `bson:"proposal"`
+	Policy          bson.Raw `bson:"policy"`
+	VotingExtension uint64   `bson:"voting_extension"`
 }
 
 func (p *ProposalStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -106,6 +109,7 @@ func (p *ProposalStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 
 	p.status = types.ProposalStatus(types.Option(u.Status))
+	p.votingExtension = u.VotingExtension
 
 	return nil
 }
@@ -248,3 +252,401 @@ func (vb *VotingPowerBoxStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) e
 
 	return nil
 }
+
+func (l LockStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": l.Hint().String(),
+			"lock":  l.lock,
+		},
+	)
+}
+
+type LockStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Lock bson.Raw `bson:"lock"`
+}
+
+func (l *LockStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of LockStateValue")
+
+	var u LockStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	l.BaseHinter = hint.NewBaseHinter(ht)
+
+	var lock types.LockInfo
+	if err := lock.DecodeBSON(u.Lock, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = lock.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		l.lock = lock
+	}
+
+	return nil
+}
+
+func (dg DelegationStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       dg.Hint().String(),
+			"delegations": dg.delegations,
+		},
+	)
+}
+
+type DelegationStateValueBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Delegations bson.Raw `bson:"delegations"`
+}
+
+func (dg *DelegationStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DelegationStateValue")
+
+	var u DelegationStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	dg.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Delegations)
+	if err != nil {
+		return err
+	}
+
+	dgs := make([]types.DelegatorInfo, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.DelegatorInfo); !ok {
+			return e.Wrap(errors.Errorf("expected types.DelegatorInfo, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			dgs[i] = v
+		}
+	}
+	dg.delegations = dgs
+
+	return nil
+}
+
+func (a AllowanceStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     a.Hint().String(),
+			"allowance": a.allowance,
+		},
+	)
+}
+
+type AllowanceStateValueBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Allowance bson.Raw `bson:"allowance"`
+}
+
+func (a *AllowanceStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of AllowanceStateValue")
+
+	var u AllowanceStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	a.BaseHinter = hint.NewBaseHinter(ht)
+
+	var allowance types.Allowance
+	if err := allowance.DecodeBSON(u.Allowance, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = allowance.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		a.allowance = allowance
+	}
+
+	return nil
+}
+
+func (d DepositStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   d.Hint().String(),
+			"deposit": d.deposit,
+		},
+	)
+}
+
+type DepositStateValueBSONUnmarshaler struct {
+	Hint    string   `bson:"_hint"`
+	Deposit bson.Raw `bson:"deposit"`
+}
+
+func (d *DepositStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DepositStateValue")
+
+	var u DepositStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	d.BaseHinter = hint.NewBaseHinter(ht)
+
+	var deposit types.Deposit
+	if err := deposit.DecodeBSON(u.Deposit, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = deposit.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		d.deposit = deposit
+	}
+
+	return nil
+}
+
+func (vt VetoesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  vt.Hint().String(),
+			"vetoes": vt.vetoes,
+		},
+	)
+}
+
+type VetoesStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Vetoes bson.Raw `bson:"vetoes"`
+}
+
+func (vt *VetoesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of VetoesStateValue")
+
+	var u VetoesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	vt.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Vetoes)
+	if err != nil {
+		return err
+	}
+
+	vetoes := make([]types.VetoRecord, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.VetoRecord); !ok {
+			return e.Wrap(errors.Errorf("expected types.VetoRecord, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			vetoes[i] = v
+		}
+	}
+	vt.vetoes = vetoes
+
+	return nil
+}
+
+func (ds DecryptionSharesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  ds.Hint().String(),
+			"shares": ds.shares,
+		},
+	)
+}
+
+type DecryptionSharesStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Shares bson.Raw `bson:"shares"`
+}
+
+func (ds *DecryptionSharesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DecryptionSharesStateValue")
+
+	var u DecryptionSharesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ds.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Shares)
+	if err != nil {
+		return err
+	}
+
+	shares := make([]types.DecryptionShare, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.DecryptionShare); !ok {
+			return e.Wrap(errors.Errorf("expected types.DecryptionShare, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			shares[i] = v
+		}
+	}
+	ds.shares = shares
+
+	return nil
+}
+
+func (sp SponsorshipStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": sp.Hint().String(),
+			"spent": sp.spent.String(),
+		},
+	)
+}
+
+type SponsorshipStateValueBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Spent string `bson:"spent"`
+}
+
+func (sp *SponsorshipStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SponsorshipStateValue")
+
+	var u SponsorshipStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	sp.BaseHinter = hint.NewBaseHinter(ht)
+
+	spent, err := common.NewBigFromString(u.Spent)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	sp.spent = spent
+
+	return nil
+}
+
+func (sf SponsoredFeesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": sf.Hint().String(),
+			"fees":  sf.fees,
+		},
+	)
+}
+
+type SponsoredFeesStateValueBSONUnmarshaler struct {
+	Hint string   `bson:"_hint"`
+	Fees bson.Raw `bson:"fees"`
+}
+
+func (sf *SponsoredFeesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SponsoredFeesStateValue")
+
+	var u SponsoredFeesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	sf.BaseHinter = hint.NewBaseHinter(ht)
+
+	hr, err := enc.DecodeSlice(u.Fees)
+	if err != nil {
+		return err
+	}
+
+	fees := make([]types.SponsoredFee, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.SponsoredFee); !ok {
+			return e.Wrap(errors.Errorf("expected types.SponsoredFee, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			fees[i] = v
+		}
+	}
+	sf.fees = fees
+
+	return nil
+}
+
+func (r ProposalResultStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  r.Hint().String(),
+			"result": r.result,
+		},
+	)
+}
+
+type ProposalResultStateValueBSONUnmarshaler struct {
+	Hint   string   `bson:"_hint"`
+	Result bson.Raw `bson:"result"`
+}
+
+func (r *ProposalResultStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalResultStateValue")
+
+	var u ProposalResultStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+
+	var result types.ProposalResult
+	if err := result.DecodeBSON(u.Result, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = result.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		r.result = result
+	}
+
+	return nil
+}
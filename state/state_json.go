@@ -3,6 +3,7 @@ package state
 import (
 	"encoding/json"
 
+	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
@@ -49,24 +50,27 @@ func (de *DesignStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 type ProposalStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Status   types.ProposalStatus `json:"status"`
-	Proposal types.Proposal       `json:"proposal"`
-	Policy   types.Policy         `json:"policy"`
+	Status          types.ProposalStatus `json:"status"`
+	Proposal        types.Proposal       `json:"proposal"`
+	Policy          types.Policy         `json:"policy"`
+	VotingExtension uint64               `json:"voting_extension"`
 }
 
 func (p ProposalStateValue) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(ProposalStateValueJSONMarshaler{
-		BaseHinter: p.BaseHinter,
-		Status:     p.Status(),
-		Proposal:   p.proposal,
-		Policy:     p.policy,
+		BaseHinter:      p.BaseHinter,
+		Status:          p.Status(),
+		Proposal:        p.proposal,
+		Policy:          p.policy,
+		VotingExtension: p.votingExtension,
 	})
 }
 
 type ProposalStateValueJSONUnmarshaler struct {
-	Status   uint8           `json:"status"`
-	Proposal json.RawMessage `json:"proposal"`
-	Policy   json.RawMessage `json:"policy"`
+	Status          uint8           `json:"status"`
+	Proposal        json.RawMessage `json:"proposal"`
+	Policy          json.RawMessage `json:"policy"`
+	VotingExtension uint64          `json:"voting_extension"`
 }
 
 func (p *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -78,6 +82,7 @@ func (p *ProposalStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	}
 
 	p.status = types.ProposalStatus(u.Status)
+	p.votingExtension = u.VotingExtension
 
 	if hinter, err := enc.Decode(u.Proposal); err != nil {
 		return e.Wrap(err)
@@ -225,3 +230,356 @@ func (vb *VotingPowerBoxStateValue) DecodeJSON(b []byte, enc encoder.Encoder) er
 
 	return nil
 }
+
+type LockStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Lock types.LockInfo `json:"lock"`
+}
+
+func (l LockStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockStateValueJSONMarshaler{
+		BaseHinter: l.BaseHinter,
+		Lock:       l.lock,
+	})
+}
+
+type LockStateValueJSONUnmarshaler struct {
+	Lock json.RawMessage `json:"lock"`
+}
+
+func (l *LockStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of LockStateValue")
+
+	var u LockStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var lock types.LockInfo
+	if err := lock.DecodeJSON(u.Lock, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = lock.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		l.lock = lock
+	}
+
+	return nil
+}
+
+type DelegationStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Delegations []types.DelegatorInfo `json:"delegations"`
+}
+
+func (dg DelegationStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DelegationStateValueJSONMarshaler{
+		BaseHinter:  dg.BaseHinter,
+		Delegations: dg.delegations,
+	})
+}
+
+type DelegationStateValueJSONUnmarshaler struct {
+	Delegations json.RawMessage `json:"delegations"`
+}
+
+func (dg *DelegationStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DelegationStateValue")
+
+	var u DelegationStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Delegations)
+	if err != nil {
+		return err
+	}
+
+	dgs := make([]types.DelegatorInfo, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.DelegatorInfo); !ok {
+			return e.Wrap(errors.Errorf("expected types.DelegatorInfo, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			dgs[i] = v
+		}
+	}
+	dg.delegations = dgs
+
+	return nil
+}
+
+type AllowanceStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Allowance types.Allowance `json:"allowance"`
+}
+
+func (a AllowanceStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AllowanceStateValueJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		Allowance:  a.allowance,
+	})
+}
+
+type AllowanceStateValueJSONUnmarshaler struct {
+	Allowance json.RawMessage `json:"allowance"`
+}
+
+func (a *AllowanceStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of AllowanceStateValue")
+
+	var u AllowanceStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var allowance types.Allowance
+	if err := allowance.DecodeJSON(u.Allowance, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = allowance.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		a.allowance = allowance
+	}
+
+	return nil
+}
+
+type DepositStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Deposit types.Deposit `json:"deposit"`
+}
+
+func (d DepositStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositStateValueJSONMarshaler{
+		BaseHinter: d.BaseHinter,
+		Deposit:    d.deposit,
+	})
+}
+
+type DepositStateValueJSONUnmarshaler struct {
+	Deposit json.RawMessage `json:"deposit"`
+}
+
+func (d *DepositStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DepositStateValue")
+
+	var u DepositStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var deposit types.Deposit
+	if err := deposit.DecodeJSON(u.Deposit, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = deposit.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		d.deposit = deposit
+	}
+
+	return nil
+}
+
+type VetoesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Vetoes []types.VetoRecord `json:"vetoes"`
+}
+
+func (vt VetoesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VetoesStateValueJSONMarshaler{
+		BaseHinter: vt.BaseHinter,
+		Vetoes:     vt.vetoes,
+	})
+}
+
+type VetoesStateValueJSONUnmarshaler struct {
+	Vetoes json.RawMessage `json:"vetoes"`
+}
+
+func (vt *VetoesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of VetoesStateValue")
+
+	var u VetoesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Vetoes)
+	if err != nil {
+		return err
+	}
+
+	vetoes := make([]types.VetoRecord, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.VetoRecord); !ok {
+			return e.Wrap(errors.Errorf("expected types.VetoRecord, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			vetoes[i] = v
+		}
+	}
+	vt.vetoes = vetoes
+
+	return nil
+}
+
+type DecryptionSharesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Shares []types.DecryptionShare `json:"shares"`
+}
+
+func (ds DecryptionSharesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DecryptionSharesStateValueJSONMarshaler{
+		BaseHinter: ds.BaseHinter,
+		Shares:     ds.shares,
+	})
+}
+
+type DecryptionSharesStateValueJSONUnmarshaler struct {
+	Shares json.RawMessage `json:"shares"`
+}
+
+func (ds *DecryptionSharesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DecryptionSharesStateValue")
+
+	var u DecryptionSharesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Shares)
+	if err != nil {
+		return err
+	}
+
+	shares := make([]types.DecryptionShare, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.DecryptionShare); !ok {
+			return e.Wrap(errors.Errorf("expected types.DecryptionShare, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			shares[i] = v
+		}
+	}
+	ds.shares = shares
+
+	return nil
+}
+
+type SponsorshipStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Spent string `json:"spent"`
+}
+
+func (sp SponsorshipStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsorshipStateValueJSONMarshaler{
+		BaseHinter: sp.BaseHinter,
+		Spent:      sp.spent.String(),
+	})
+}
+
+type SponsorshipStateValueJSONUnmarshaler struct {
+	Spent string `json:"spent"`
+}
+
+func (sp *SponsorshipStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SponsorshipStateValue")
+
+	var u SponsorshipStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	spent, err := common.NewBigFromString(u.Spent)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	sp.spent = spent
+
+	return nil
+}
+
+type SponsoredFeesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Fees []types.SponsoredFee `json:"fees"`
+}
+
+func (sf SponsoredFeesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsoredFeesStateValueJSONMarshaler{
+		BaseHinter: sf.BaseHinter,
+		Fees:       sf.fees,
+	})
+}
+
+type SponsoredFeesStateValueJSONUnmarshaler struct {
+	Fees json.RawMessage `json:"fees"`
+}
+
+func (sf *SponsoredFeesStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SponsoredFeesStateValue")
+
+	var u SponsoredFeesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hr, err := enc.DecodeSlice(u.Fees)
+	if err != nil {
+		return err
+	}
+
+	fees := make([]types.SponsoredFee, len(hr))
+	for i, hinter := range hr {
+		if v, ok := hinter.(types.SponsoredFee); !ok {
+			return e.Wrap(errors.Errorf("expected types.SponsoredFee, not %T", hinter))
+		} else if err := v.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		} else {
+			fees[i] = v
+		}
+	}
+	sf.fees = fees
+
+	return nil
+}
+
+type ProposalResultStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Result types.ProposalResult `json:"result"`
+}
+
+func (r ProposalResultStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposalResultStateValueJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Result:     r.result,
+	})
+}
+
+type ProposalResultStateValueJSONUnmarshaler struct {
+	Result json.RawMessage `json:"result"`
+}
+
+func (r *ProposalResultStateValue) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalResultStateValue")
+
+	var u ProposalResultStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	var result types.ProposalResult
+	if err := result.DecodeJSON(u.Result, enc); err != nil {
+		return e.Wrap(err)
+	} else if err = result.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	} else {
+		r.result = result
+	}
+
+	return nil
+}
@@ -1,21 +1,22 @@
 package state
 
 import (
-	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum-dao/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/pkg/errors"
-	"sort"
-	"strings"
-	"sync"
 )
 
 type VotersStateValueMerger struct {
 	*common.BaseStateValueMerger
 	existing map[string]types.VoterInfo
-	add      map[string]types.VoterInfo
+	add      map[string]map[string]base.Address
+	remove   map[string]map[string]struct{}
 	sync.Mutex
 }
 
@@ -30,10 +31,11 @@ func NewVotersStateValueMerger(height base.Height, key string, st base.State) *V
 	}
 
 	s.existing = make(map[string]types.VoterInfo)
-	s.add = make(map[string]types.VoterInfo)
+	s.add = make(map[string]map[string]base.Address)
+	s.remove = make(map[string]map[string]struct{})
 	var voters []types.VoterInfo
 	if nst.Value() != nil {
-		voters = nst.Value().(VotersStateValue).voters
+		voters = nst.Value().(VotersStateValue).voters //nolint:forcetypeassert //...
 		for i := range voters {
 			s.existing[voters[i].Account().String()] = voters[i]
 		}
@@ -49,14 +51,38 @@ func (s *VotersStateValueMerger) Merge(value base.StateValue, op util.Hash) erro
 	switch t := value.(type) {
 	case VotersStateValue:
 		for i := range t.voters {
-			switch v, found := s.add[t.voters[i].Account().String()]; {
-			case !found:
-				s.add[t.voters[i].Account().String()] = t.voters[i]
-			default:
-				delegators := append(v.Delegators(), t.voters[i].Delegators()...)
-				delegators, _ = util.RemoveDuplicatedSlice(delegators, func(address base.Address) (string, error) { return address.String(), nil })
-				v.SetDelegators(delegators)
-				s.add[t.voters[i].Account().String()] = v
+			k := t.voters[i].Account().String()
+
+			if _, found := s.existing[k]; !found {
+				s.existing[k] = types.NewVoterInfo(t.voters[i].Account(), nil)
+			}
+
+			if _, found := s.add[k]; !found {
+				s.add[k] = map[string]base.Address{}
+			}
+
+			for _, delegator := range t.voters[i].Delegators() {
+				if r, found := s.remove[k]; found {
+					delete(r, delegator.String())
+				}
+
+				s.add[k][delegator.String()] = delegator
+			}
+		}
+	case RemoveVotersStateValue:
+		for i := range t.voters {
+			k := t.voters[i].Account().String()
+
+			if _, found := s.remove[k]; !found {
+				s.remove[k] = map[string]struct{}{}
+			}
+
+			for _, delegator := range t.voters[i].Delegators() {
+				if a, found := s.add[k]; found {
+					delete(a, delegator.String())
+				}
+
+				s.remove[k][delegator.String()] = struct{}{}
 			}
 		}
 	default:
@@ -84,27 +110,40 @@ func (s *VotersStateValueMerger) CloseValue() (base.State, error) {
 
 func (s *VotersStateValueMerger) closeValue() (base.StateValue, error) {
 	var nvoters []types.VoterInfo
-	if len(s.add) > 0 {
-		for k, v := range s.add {
-			switch value, found := s.existing[k]; {
-			case !found:
-				s.existing[k] = v
-			default:
-				delegators := append(value.Delegators(), v.Delegators()...)
-				delegators, _ = util.RemoveDuplicatedSlice(delegators, func(address base.Address) (string, error) { return address.String(), nil })
-				value.SetDelegators(delegators)
-				s.existing[k] = value
+	for k, v := range s.existing {
+		var delegators []base.Address
+
+		for _, delegator := range v.Delegators() {
+			if r, found := s.remove[k]; found {
+				if _, found := r[delegator.String()]; found {
+					continue
+				}
 			}
+
+			delegators = append(delegators, delegator)
 		}
-	}
-	for _, v := range s.existing {
+
+		for _, delegator := range s.add[k] {
+			delegators = append(delegators, delegator)
+		}
+
+		delegators, _ = util.RemoveDuplicatedSlice(delegators, func(address base.Address) (string, error) { return address.String(), nil })
+		if len(delegators) < 1 {
+			continue
+		}
+
+		sort.Slice(delegators, func(i, j int) bool { // NOTE sort by address
+			return strings.Compare(delegators[i].String(), delegators[j].String()) < 0
+		})
+
+		v.SetDelegators(delegators)
 		nvoters = append(nvoters, v)
 	}
 
 	sort.Slice(nvoters, func(i, j int) bool { // NOTE sort by address
 		return strings.Compare(nvoters[i].Account().String(), nvoters[j].Account().String()) < 0
 	})
-	fmt.Println(nvoters)
+
 	return NewVotersStateValue(
 		nvoters,
 	), nil
@@ -112,8 +151,9 @@ func (s *VotersStateValueMerger) closeValue() (base.StateValue, error) {
 
 type DelegatorsStateValueMerger struct {
 	*common.BaseStateValueMerger
-	existing []types.DelegatorInfo
-	add      []types.DelegatorInfo
+	existing map[string]types.DelegatorInfo
+	add      map[string]types.DelegatorInfo
+	remove   map[string]struct{}
 	sync.Mutex
 }
 
@@ -127,8 +167,14 @@ func NewDelegatorsStateValueMerger(height base.Height, key string, st base.State
 		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
 	}
 
+	s.existing = make(map[string]types.DelegatorInfo)
+	s.add = make(map[string]types.DelegatorInfo)
+	s.remove = make(map[string]struct{})
 	if nst.Value() != nil {
-		s.existing = nst.Value().(DelegatorsStateValue).delegators //nolint:forcetypeassert //...
+		delegators := nst.Value().(DelegatorsStateValue).delegators //nolint:forcetypeassert //...
+		for i := range delegators {
+			s.existing[delegators[i].Account().String()] = delegators[i]
+		}
 	}
 
 	return s
@@ -140,7 +186,19 @@ func (s *DelegatorsStateValueMerger) Merge(value base.StateValue, op util.Hash)
 
 	switch t := value.(type) {
 	case DelegatorsStateValue:
-		s.add = append(s.add, t.delegators...)
+		for i := range t.delegators {
+			k := t.delegators[i].Account().String()
+
+			delete(s.remove, k)
+			s.add[k] = t.delegators[i]
+		}
+	case RemoveDelegatorsStateValue:
+		for i := range t.accounts {
+			k := t.accounts[i].String()
+
+			delete(s.add, k)
+			s.remove[k] = struct{}{}
+		}
 	default:
 		return errors.Errorf("unsupported delegators state value, %T", value)
 	}
@@ -165,19 +223,454 @@ func (s *DelegatorsStateValueMerger) CloseValue() (base.State, error) {
 }
 
 func (s *DelegatorsStateValueMerger) closeValue() (base.StateValue, error) {
-	var ndelegators []types.DelegatorInfo
-	if len(s.add) > 0 {
-		ndelegators = append(s.existing, s.add...)
-	} else {
-		ndelegators = s.existing
+	for k := range s.remove {
+		delete(s.existing, k)
+	}
+
+	for k, v := range s.add {
+		s.existing[k] = v
+	}
+
+	ndelegators := make([]types.DelegatorInfo, 0, len(s.existing))
+	for _, v := range s.existing {
+		ndelegators = append(ndelegators, v)
 	}
 
-	rdelegators, _ := util.RemoveDuplicatedSlice(ndelegators, func(v types.DelegatorInfo) (string, error) { return string(v.Bytes()), nil })
-	sort.Slice(rdelegators, func(i, j int) bool { // NOTE sort by address
-		return strings.Compare(string(rdelegators[i].Bytes()), string(rdelegators[j].Bytes())) < 0
+	sort.Slice(ndelegators, func(i, j int) bool { // NOTE sort by address
+		return strings.Compare(string(ndelegators[i].Bytes()), string(ndelegators[j].Bytes())) < 0
 	})
 
 	return NewDelegatorsStateValue(
-		rdelegators,
+		ndelegators,
+	), nil
+}
+
+type DelegationStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing map[string]types.DelegatorInfo
+	add      map[string]types.DelegatorInfo
+	remove   map[string]struct{}
+	sync.Mutex
+}
+
+func NewDelegationStateValueMerger(height base.Height, key string, st base.State) *DelegationStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &DelegationStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.existing = make(map[string]types.DelegatorInfo)
+	s.add = make(map[string]types.DelegatorInfo)
+	s.remove = make(map[string]struct{})
+	if nst.Value() != nil {
+		delegations := nst.Value().(DelegationStateValue).delegations //nolint:forcetypeassert //...
+		for i := range delegations {
+			s.existing[delegations[i].Account().String()] = delegations[i]
+		}
+	}
+
+	return s
+}
+
+func (s *DelegationStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case DelegationStateValue:
+		for i := range t.delegations {
+			k := t.delegations[i].Account().String()
+
+			delete(s.remove, k)
+			s.add[k] = t.delegations[i]
+		}
+	case RemoveDelegationStateValue:
+		for i := range t.accounts {
+			k := t.accounts[i].String()
+
+			delete(s.add, k)
+			s.remove[k] = struct{}{}
+		}
+	default:
+		return errors.Errorf("unsupported delegation state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *DelegationStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close DelegationStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *DelegationStateValueMerger) closeValue() (base.StateValue, error) {
+	for k := range s.remove {
+		delete(s.existing, k)
+	}
+
+	for k, v := range s.add {
+		s.existing[k] = v
+	}
+
+	ndelegations := make([]types.DelegatorInfo, 0, len(s.existing))
+	for _, v := range s.existing {
+		ndelegations = append(ndelegations, v)
+	}
+
+	sort.Slice(ndelegations, func(i, j int) bool { // NOTE sort by address
+		return strings.Compare(ndelegations[i].Account().String(), ndelegations[j].Account().String()) < 0
+	})
+
+	return NewDelegationStateValue(
+		ndelegations,
+	), nil
+}
+
+type SponsorshipStateValueMerger struct {
+	*common.BaseStateValueMerger
+	spent  common.Big
+	budget common.Big
+	sync.Mutex
+}
+
+func NewSponsorshipStateValueMerger(height base.Height, key string, budget common.Big, st base.State) *SponsorshipStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &SponsorshipStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.budget = budget
+	s.spent = common.ZeroBig
+	if nst.Value() != nil {
+		s.spent = nst.Value().(SponsorshipStateValue).spent //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+// Merge adds the spent of the value, the fee of a sponsored operation, to the
+// sum of the sponsored fees. The fees sponsored together in a block are checked
+// against the budget only here, so the sum over the budget fails the merge.
+func (s *SponsorshipStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case SponsorshipStateValue:
+		spent := s.spent.Add(t.spent)
+		if spent.Compare(s.budget) > 0 {
+			return errors.Errorf("sponsored fees over budget, %q > %q", spent, s.budget)
+		}
+		s.spent = spent
+	default:
+		return errors.Errorf("unsupported sponsorship state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *SponsorshipStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.BaseStateValueMerger.SetValue(NewSponsorshipStateValue(s.spent))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+type SponsoredFeesStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing   map[string]types.SponsoredFee
+	accountCap common.Big
+	sync.Mutex
+}
+
+func NewSponsoredFeesStateValueMerger(height base.Height, key string, accountCap common.Big, st base.State) *SponsoredFeesStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &SponsoredFeesStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.accountCap = accountCap
+	s.existing = make(map[string]types.SponsoredFee)
+	if nst.Value() != nil {
+		fees := nst.Value().(SponsoredFeesStateValue).fees //nolint:forcetypeassert //...
+		for i := range fees {
+			s.existing[fees[i].Account().String()] = fees[i]
+		}
+	}
+
+	return s
+}
+
+// Merge adds the amount of each fee of the value to the sponsored fee of the
+// account. The sponsored fee of the account over the cap fails the merge.
+func (s *SponsoredFeesStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case SponsoredFeesStateValue:
+		for i := range t.fees {
+			k := t.fees[i].Account().String()
+
+			fee := t.fees[i]
+			if e, found := s.existing[k]; found {
+				fee = types.NewSponsoredFee(e.Account(), e.Amount().Add(fee.Amount()))
+			}
+
+			if fee.Amount().Compare(s.accountCap) > 0 {
+				return errors.Errorf("sponsored fee of account over cap, %s, %q > %q", fee.Account(), fee.Amount(), s.accountCap)
+			}
+			s.existing[k] = fee
+		}
+	default:
+		return errors.Errorf("unsupported sponsored fees state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *SponsoredFeesStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	nfees := make([]types.SponsoredFee, 0, len(s.existing))
+	for _, v := range s.existing {
+		nfees = append(nfees, v)
+	}
+
+	sort.Slice(nfees, func(i, j int) bool { // NOTE sort by address
+		return strings.Compare(nfees[i].Account().String(), nfees[j].Account().String()) < 0
+	})
+
+	s.BaseStateValueMerger.SetValue(NewSponsoredFeesStateValue(nfees))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+type LockStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing types.LockInfo
+	add      common.Big
+	remove   common.Big
+	adds     []string
+	removes  map[string]struct{}
+	sync.Mutex
+}
+
+func NewLockStateValueMerger(height base.Height, key string, st base.State) *LockStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &LockStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.add = common.ZeroBig
+	s.remove = common.ZeroBig
+	s.removes = map[string]struct{}{}
+	if nst.Value() != nil {
+		s.existing = nst.Value().(LockStateValue).lock //nolint:forcetypeassert //...
+	}
+
+	return s
+}
+
+// Merge adds the amount and the proposals of LockStateValue to the lock, and
+// deducts the amount and removes the proposals of RemoveLockStateValue from
+// the lock. The currency of the lock follows the amount added to the empty
+// lock; an amount of another currency than the locked one is not merged.
+func (s *LockStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	var lock types.LockInfo
+
+	switch t := value.(type) {
+	case LockStateValue:
+		lock = t.lock
+
+		if s.existing.Account() != nil && lock.Amount().OverZero() && lock.Currency() != s.existing.Currency() {
+			if s.existing.Amount().OverZero() || s.add.OverZero() {
+				return errors.Errorf("lock currency not matched, %q != %q", lock.Currency(), s.existing.Currency())
+			}
+
+			s.existing = types.NewLockInfo(s.existing.Account(), lock.Currency(), s.existing.Amount(), s.existing.Proposals())
+		}
+
+		s.add = s.add.Add(lock.Amount())
+
+		for _, pid := range lock.Proposals() {
+			delete(s.removes, pid)
+			s.adds = append(s.adds, pid)
+		}
+	case RemoveLockStateValue:
+		lock = t.lock
+
+		if s.existing.Account() != nil && lock.Amount().OverZero() && lock.Currency() != s.existing.Currency() {
+			return errors.Errorf("lock currency not matched, %q != %q", lock.Currency(), s.existing.Currency())
+		}
+
+		s.remove = s.remove.Add(lock.Amount())
+
+		for _, pid := range lock.Proposals() {
+			s.removes[pid] = struct{}{}
+		}
+	default:
+		return errors.Errorf("unsupported lock state value, %T", value)
+	}
+
+	if s.existing.Account() == nil {
+		s.existing = types.NewLockInfo(lock.Account(), lock.Currency(), common.ZeroBig, nil)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *LockStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	newValue, err := s.closeValue()
+	if err != nil {
+		return nil, errors.WithMessage(err, "close LockStateValueMerger")
+	}
+
+	s.BaseStateValueMerger.SetValue(newValue)
+
+	return s.BaseStateValueMerger.CloseValue()
+}
+
+func (s *LockStateValueMerger) closeValue() (base.StateValue, error) {
+	amount := s.existing.Amount().Add(s.add).Sub(s.remove)
+	if amount.Compare(common.ZeroBig) < 0 {
+		return nil, errors.Errorf("under zero lock amount, %v", amount)
+	}
+
+	pids := make([]string, 0, len(s.existing.Proposals())+len(s.adds))
+	pids = append(pids, s.existing.Proposals()...)
+	pids = append(pids, s.adds...)
+
+	proposals := []string{}
+	founds := map[string]struct{}{}
+	for _, pid := range pids {
+		if _, found := s.removes[pid]; found {
+			continue
+		}
+
+		if _, found := founds[pid]; found {
+			continue
+		}
+
+		founds[pid] = struct{}{}
+		proposals = append(proposals, pid)
+	}
+
+	return NewLockStateValue(
+		types.NewLockInfo(s.existing.Account(), s.existing.Currency(), amount, proposals),
 	), nil
 }
+
+type VetoesStateValueMerger struct {
+	*common.BaseStateValueMerger
+	existing map[string]types.VetoRecord
+	sync.Mutex
+}
+
+func NewVetoesStateValueMerger(height base.Height, key string, st base.State) *VetoesStateValueMerger {
+	nst := st
+	if st == nil {
+		nst = common.NewBaseState(base.NilHeight, key, nil, nil, nil)
+	}
+
+	s := &VetoesStateValueMerger{
+		BaseStateValueMerger: common.NewBaseStateValueMerger(height, nst.Key(), nst),
+	}
+
+	s.existing = make(map[string]types.VetoRecord)
+	if nst.Value() != nil {
+		vetoes := nst.Value().(VetoesStateValue).vetoes //nolint:forcetypeassert //...
+		for i := range vetoes {
+			s.existing[vetoes[i].Guardian().String()] = vetoes[i]
+		}
+	}
+
+	return s
+}
+
+// Merge adds the vetoes of the value; the first veto of a guardian is kept.
+func (s *VetoesStateValueMerger) Merge(value base.StateValue, op util.Hash) error {
+	s.Lock()
+	defer s.Unlock()
+
+	switch t := value.(type) {
+	case VetoesStateValue:
+		for i := range t.vetoes {
+			k := t.vetoes[i].Guardian().String()
+
+			if _, found := s.existing[k]; !found {
+				s.existing[k] = t.vetoes[i]
+			}
+		}
+	default:
+		return errors.Errorf("unsupported vetoes state value, %T", value)
+	}
+
+	s.AddOperation(op)
+
+	return nil
+}
+
+func (s *VetoesStateValueMerger) CloseValue() (base.State, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	nvetoes := make([]types.VetoRecord, 0, len(s.existing))
+	for _, v := range s.existing {
+		nvetoes = append(nvetoes, v)
+	}
+
+	sort.Slice(nvetoes, func(i, j int) bool { // NOTE sort by vetoed time and address
+		if nvetoes[i].VetoedAt() != nvetoes[j].VetoedAt() {
+			return nvetoes[i].VetoedAt() < nvetoes[j].VetoedAt()
+		}
+
+		return strings.Compare(nvetoes[i].Guardian().String(), nvetoes[j].Guardian().String()) < 0
+	})
+
+	s.BaseStateValueMerger.SetValue(NewVetoesStateValue(nvetoes))
+
+	return s.BaseStateValueMerger.CloseValue()
+}
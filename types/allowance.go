@@ -0,0 +1,88 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var AllowanceHint = hint.MustNewHint("mitum-dao-allowance-v0.0.1")
+
+// Allowance is the amount of a currency an account allows the dao contract to transfer
+// from its balance by executing proposals. expiry is unix time in seconds, 0 means no expiry.
+type Allowance struct {
+	hint.BaseHinter
+	account  base.Address
+	currency currencytypes.CurrencyID
+	amount   common.Big
+	expiry   uint64
+}
+
+func NewAllowance(account base.Address, currency currencytypes.CurrencyID, amount common.Big, expiry uint64) Allowance {
+	return Allowance{
+		BaseHinter: hint.NewBaseHinter(AllowanceHint),
+		account:    account,
+		currency:   currency,
+		amount:     amount,
+		expiry:     expiry,
+	}
+}
+
+func (a Allowance) Hint() hint.Hint {
+	return a.BaseHinter.Hint()
+}
+
+func (a Allowance) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid Allowance")
+
+	if err := a.BaseHinter.IsValid(AllowanceHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, a.account, a.currency, a.amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	if a.amount.Compare(common.ZeroBig) < 0 {
+		return e.Wrap(errors.Errorf("negative allowance amount, %v", a.amount))
+	}
+
+	return nil
+}
+
+func (a Allowance) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		a.account.Bytes(),
+		a.currency.Bytes(),
+		a.amount.Bytes(),
+		util.Uint64ToBytes(a.expiry),
+	)
+}
+
+func (a Allowance) Account() base.Address {
+	return a.account
+}
+
+func (a Allowance) Currency() currencytypes.CurrencyID {
+	return a.currency
+}
+
+func (a Allowance) Amount() common.Big {
+	return a.amount
+}
+
+func (a *Allowance) SetAmount(amount common.Big) {
+	a.amount = amount
+}
+
+func (a Allowance) Expiry() uint64 {
+	return a.expiry
+}
+
+// IsExpired returns true if the allowance has an expiry and now, unix time in seconds, reached it.
+func (a Allowance) IsExpired(now uint64) bool {
+	return a.expiry > 0 && now >= a.expiry
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (a Allowance) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    a.Hint().String(),
+			"account":  a.account,
+			"currency": a.currency,
+			"amount":   a.amount.String(),
+			"expiry":   a.expiry,
+		},
+	)
+}
+
+type AllowanceBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Account  string `bson:"account"`
+	Currency string `bson:"currency"`
+	Amount   string `bson:"amount"`
+	Expiry   uint64 `bson:"expiry"`
+}
+
+func (a *Allowance) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Allowance")
+
+	var u AllowanceBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, ht, u.Account, u.Currency, u.Amount, u.Expiry)
+}
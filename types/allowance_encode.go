@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (a *Allowance) unpack(enc encoder.Encoder, ht hint.Hint, ac, cr, am string, ex uint64) error {
+	e := util.StringError("failed to unmarshal Allowance")
+
+	a.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch ad, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		a.account = ad
+	}
+
+	a.currency = currencytypes.CurrencyID(cr)
+
+	big, err := common.NewBigFromString(am)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	a.amount = big
+	a.expiry = ex
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AllowanceJSONMarshaler struct {
+	hint.BaseHinter
+	Account  base.Address             `json:"account"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+	Amount   string                   `json:"amount"`
+	Expiry   uint64                   `json:"expiry"`
+}
+
+func (a Allowance) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AllowanceJSONMarshaler{
+		BaseHinter: a.BaseHinter,
+		Account:    a.account,
+		Currency:   a.currency,
+		Amount:     a.amount.String(),
+		Expiry:     a.expiry,
+	})
+}
+
+type AllowanceJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Account  string    `json:"account"`
+	Currency string    `json:"currency"`
+	Amount   string    `json:"amount"`
+	Expiry   uint64    `json:"expiry"`
+}
+
+func (a *Allowance) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Allowance")
+
+	var u AllowanceJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return a.unpack(enc, u.Hint, u.Account, u.Currency, u.Amount, u.Expiry)
+}
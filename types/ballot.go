@@ -0,0 +1,78 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+	"github.com/pkg/errors"
+)
+
+// Ballot is the list of vote options of an approval or a ranked-choice vote.
+// For ranked-choice voting the options are ordered by preference.
+type Ballot []uint8
+
+func (b Ballot) IsValid([]byte) error {
+	founds := map[uint8]struct{}{}
+	for _, o := range b {
+		if _, found := founds[o]; found {
+			return util.ErrInvalid.Errorf("duplicate option in ballot, %d", o)
+		}
+
+		founds[o] = struct{}{}
+	}
+
+	return nil
+}
+
+func (b Ballot) Bytes() []byte {
+	return []byte(b)
+}
+
+// Contains returns true when the option is in the ballot.
+func (b Ballot) Contains(option uint8) bool {
+	for _, o := range b {
+		if o == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b Ballot) MarshalJSON() ([]byte, error) {
+	return json.Marshal(optionsToUints(b))
+}
+
+func (b *Ballot) UnmarshalJSON(data []byte) error {
+	var options []uint
+	if err := json.Unmarshal(data, &options); err != nil {
+		return err
+	}
+
+	ballot := make(Ballot, len(options))
+	for i, o := range options {
+		if o > 255 {
+			return errors.Errorf("ballot option out of range, %d", o)
+		}
+
+		ballot[i] = uint8(o)
+	}
+	*b = ballot
+
+	return nil
+}
+
+// NewVoteCommitment returns the commitment of a commit-reveal vote. The voter
+// and the proposal are committed with the vote, so the commitment of another
+// voter can not be copied.
+func NewVoteCommitment(voter base.Address, proposalID string, vote uint8, ballot Ballot, salt string) string {
+	return valuehash.NewSHA256(util.ConcatBytesSlice(
+		voter.Bytes(),
+		[]byte(proposalID),
+		util.Uint8ToBytes(vote),
+		ballot.Bytes(),
+		[]byte(salt),
+	)).String()
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/operation/extension"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -10,11 +12,13 @@ import (
 const (
 	CalldataTransfer   = "transfer"
 	CalldataGovernance = "governance"
+	CalldataOperation  = "operation"
 )
 
 var (
 	TransferCalldataHint   = hint.MustNewHint("mitum-dao-transfer-calldata-v0.0.1")
 	GovernanceCalldataHint = hint.MustNewHint("mitum-dao-governance-calldata-v0.0.1")
+	OperationCalldataHint  = hint.MustNewHint("mitum-dao-operation-calldata-v0.0.1")
 )
 
 type CallData interface {
@@ -129,3 +133,112 @@ func (cd GovernanceCallData) IsValid([]byte) error {
 func (cd GovernanceCallData) Addresses() []base.Address {
 	return cd.policy.whitelist.accounts
 }
+
+// OperationCallDataHints is the operations which can be embedded in
+// OperationCallData. The embedded facts skip the PreProcess of their
+// processors, so only the operations whose PreProcess checks on the targets
+// are done again by the dao on execution are allowed.
+var OperationCallDataHints = []hint.Hint{
+	currency.CreateAccountHint,
+	extensioncurrency.WithdrawHint,
+}
+
+// OperationCallData embeds the fact of an operation of OperationCallDataHints.
+// On execution the fact is processed by the processor of the operation hint
+// with the dao contract account as its sender, instead of signatures.
+type OperationCallData struct {
+	hint.BaseHinter
+	operation hint.Hint
+	fact      base.Fact
+}
+
+func NewOperationCallData(operation hint.Hint, fact base.Fact) OperationCallData {
+	return OperationCallData{
+		BaseHinter: hint.NewBaseHinter(OperationCalldataHint),
+		operation:  operation,
+		fact:       fact,
+	}
+}
+
+func (OperationCallData) Type() string {
+	return CalldataOperation
+}
+
+func (cd OperationCallData) Bytes() []byte {
+	var fh []byte
+	if cd.fact != nil {
+		fh = cd.fact.Hash().Bytes()
+	}
+
+	return util.ConcatBytesSlice([]byte(cd.operation.String()), fh)
+}
+
+func (cd OperationCallData) Operation() hint.Hint {
+	return cd.operation
+}
+
+func (cd OperationCallData) Fact() base.Fact {
+	return cd.fact
+}
+
+// Sender returns the sender of the embedded fact; it is nil when the fact
+// has no sender.
+func (cd OperationCallData) Sender() base.Address {
+	if i, ok := cd.fact.(interface{ Sender() base.Address }); ok {
+		return i.Sender()
+	}
+
+	return nil
+}
+
+func (cd OperationCallData) IsValid([]byte) error {
+	if err := cd.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := cd.operation.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("operation calldata - invalid operation hint: %v", err)
+	}
+
+	if !IsOperationCallDataHint(cd.operation) {
+		return util.ErrInvalid.Errorf("operation calldata - operation not allowed, %q", cd.operation)
+	}
+
+	if cd.fact == nil {
+		return util.ErrInvalid.Errorf("operation calldata - empty fact")
+	}
+
+	if err := cd.fact.IsValid(nil); err != nil {
+		return util.ErrInvalid.Errorf("operation calldata - invalid fact: %v", err)
+	}
+
+	if cd.Sender() == nil {
+		return util.ErrInvalid.Errorf("operation calldata - fact without sender, %T", cd.fact)
+	}
+
+	return nil
+}
+
+// IsOperationCallDataHint returns true when the operation can be embedded in
+// OperationCallData.
+func IsOperationCallDataHint(ht hint.Hint) bool {
+	for _, h := range OperationCallDataHints {
+		if h.Type() == ht.Type() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (cd OperationCallData) Addresses() []base.Address {
+	if i, ok := cd.fact.(interface {
+		Addresses() ([]base.Address, error)
+	}); ok {
+		if as, err := i.Addresses(); err == nil {
+			return as
+		}
+	}
+
+	return []base.Address{cd.Sender()}
+}
@@ -70,3 +70,35 @@ func (cd *GovernanceCallData) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 
 	return cd.unpack(enc, ht, uc.Policy)
 }
+
+func (cd OperationCallData) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     cd.Hint().String(),
+			"operation": cd.operation.String(),
+			"fact":      cd.fact,
+		},
+	)
+}
+
+type OperationCalldataBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Operation string   `bson:"operation"`
+	Fact      bson.Raw `bson:"fact"`
+}
+
+func (cd *OperationCallData) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of OperationCallData")
+
+	var uc OperationCalldataBSONUnmarshaler
+	if err := enc.Unmarshal(b, &uc); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uc.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return cd.unpack(enc, ht, uc.Operation, uc.Fact)
+}
@@ -54,3 +54,25 @@ func (cd *GovernanceCallData) unpack(enc encoder.Encoder, ht hint.Hint, bpo []by
 
 	return nil
 }
+
+func (cd *OperationCallData) unpack(enc encoder.Encoder, ht hint.Hint, oh string, bf []byte) error {
+	e := util.StringError("failed to unmarshal OperationCallData")
+
+	cd.BaseHinter = hint.NewBaseHinter(ht)
+
+	operation, err := hint.ParseHint(oh)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	cd.operation = operation
+
+	if hinter, err := enc.Decode(bf); err != nil {
+		return e.Wrap(err)
+	} else if fact, ok := hinter.(base.Fact); !ok {
+		return e.Wrap(errors.Errorf("expected Fact, not %T", hinter))
+	} else {
+		cd.fact = fact
+	}
+
+	return nil
+}
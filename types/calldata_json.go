@@ -71,3 +71,34 @@ func (cd *GovernanceCallData) DecodeJSON(b []byte, enc encoder.Encoder) error {
 
 	return cd.unpack(enc, uc.Hint, uc.Policy)
 }
+
+type OperationCalldataJSONMarshaler struct {
+	hint.BaseHinter
+	Operation hint.Hint `json:"operation"`
+	Fact      base.Fact `json:"fact"`
+}
+
+func (cd OperationCallData) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(OperationCalldataJSONMarshaler{
+		BaseHinter: cd.BaseHinter,
+		Operation:  cd.operation,
+		Fact:       cd.fact,
+	})
+}
+
+type OperationCalldataJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Operation string          `json:"operation"`
+	Fact      json.RawMessage `json:"fact"`
+}
+
+func (cd *OperationCallData) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of OperationCallData")
+
+	var uc OperationCalldataJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uc); err != nil {
+		return e.Wrap(err)
+	}
+
+	return cd.unpack(enc, uc.Hint, uc.Operation, uc.Fact)
+}
@@ -0,0 +1,266 @@
+package types
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var DecryptionShareHint = hint.MustNewHint("mitum-dao-decryption-share-v0.0.1")
+
+// DecryptionShare is the share of a tally committee member decrypting the
+// encrypted tally of a proposal. A member may claim the decrypted result; the
+// claim is verified when the shares of the committee threshold are combined.
+type DecryptionShare struct {
+	hint.BaseHinter
+	member base.Address
+	shares []string
+	result map[uint8]common.Big
+}
+
+func NewDecryptionShare(member base.Address, shares []string, result map[uint8]common.Big) DecryptionShare {
+	return DecryptionShare{
+		BaseHinter: hint.NewBaseHinter(DecryptionShareHint),
+		member:     member,
+		shares:     shares,
+		result:     result,
+	}
+}
+
+func (ds DecryptionShare) Hint() hint.Hint {
+	return ds.BaseHinter.Hint()
+}
+
+func (ds DecryptionShare) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid DecryptionShare")
+
+	if err := ds.BaseHinter.IsValid(DecryptionShareHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := ds.member.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(ds.shares) < 1 {
+		return e.Wrap(errors.Errorf("empty shares"))
+	}
+
+	if err := isValidResult(ds.result); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ds DecryptionShare) Bytes() []byte {
+	bs := make([][]byte, len(ds.shares))
+	for i := range ds.shares {
+		bs[i] = []byte(ds.shares[i])
+	}
+
+	return util.ConcatBytesSlice(
+		ds.member.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		resultBytes(ds.result),
+	)
+}
+
+func (ds DecryptionShare) Member() base.Address {
+	return ds.member
+}
+
+// Shares returns the decryption shares of the options.
+func (ds DecryptionShare) Shares() []string {
+	return ds.shares
+}
+
+// Result returns the decrypted result claimed by the member, empty if not claimed.
+func (ds DecryptionShare) Result() map[uint8]common.Big {
+	return ds.result
+}
+
+// DecryptionShareContext returns the context of the proofs of the decryption
+// shares of the member.
+func DecryptionShareContext(member base.Address, proposalID string) []byte {
+	return util.ConcatBytesSlice(member.Bytes(), []byte(proposalID))
+}
+
+// CreateDecryptionShares creates the decryption shares of the encrypted tally
+// by the secret key share of a committee member with the proofs that the
+// shares are made by the key share of the verification key.
+func CreateDecryptionShares(secretShare string, tally []string, context []byte) ([]string, []string, error) {
+	e := util.StringError("failed to create decryption shares")
+
+	s, err := parseScalar(secretShare)
+	if err != nil {
+		return nil, nil, e.Wrap(err)
+	}
+	vk := expP(elGamalG, s)
+
+	shares := make([]string, len(tally))
+	proofs := make([]string, len(tally))
+	for i := range tally {
+		ct, err := parseCiphertext(tally[i])
+		if err != nil {
+			return nil, nil, e.Wrap(err)
+		}
+
+		d := expP(ct.a, s)
+		proof, err := proveDLEQ(context, s, elGamalG, vk, ct.a, d)
+		if err != nil {
+			return nil, nil, e.Wrap(err)
+		}
+
+		shares[i], proofs[i] = d.Text(16), proof
+	}
+
+	return shares, proofs, nil
+}
+
+// VerifyDecryptionShares verifies the decryption shares of the encrypted tally
+// are made by the key share of the verification key.
+func VerifyDecryptionShares(verificationKey string, tally, shares, proofs []string, context []byte) error {
+	if len(shares) != len(tally) || len(proofs) != len(tally) {
+		return errors.Errorf("shares not matched with tally, %d, %d != %d", len(shares), len(proofs), len(tally))
+	}
+
+	vk, err := parseGroupElement(verificationKey)
+	if err != nil {
+		return err
+	}
+
+	for i := range tally {
+		ct, err := parseCiphertext(tally[i])
+		if err != nil {
+			return err
+		}
+
+		d, err := parseGroupElement(shares[i])
+		if err != nil {
+			return errors.Wrapf(err, "option %d", i)
+		}
+
+		if err := verifyDLEQ(context, proofs[i], elGamalG, vk, ct.a, d); err != nil {
+			return errors.Wrapf(err, "option %d", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyDecryptedResult combines the decryption shares of the committee
+// threshold and returns the first claimed result matched with the decrypted
+// tally. The shares are combined in the order of the committee members.
+func VerifyDecryptedResult(committee TallyCommittee, tally []string, shares []DecryptionShare) (map[uint8]common.Big, error) {
+	if uint(len(shares)) < committee.Threshold() {
+		return nil, errors.Errorf("not enough decryption shares, %d < %d", len(shares), committee.Threshold())
+	}
+
+	combined := make([]DecryptionShare, len(shares))
+	copy(combined, shares)
+	sort.Slice(combined, func(i, j int) bool {
+		a, _ := committee.Index(combined[i].Member())
+		b, _ := committee.Index(combined[j].Member())
+
+		return a < b
+	})
+	combined = combined[:committee.Threshold()]
+
+	indices := make([]uint64, len(combined))
+	for i := range combined {
+		index, found := committee.Index(combined[i].Member())
+		if !found {
+			return nil, errors.Errorf("not tally committee member, %s", combined[i].Member())
+		}
+		indices[i] = index
+	}
+
+	// g^m of each option; b / a^s where a^s is interpolated from the shares
+	decrypted := make([]*big.Int, len(tally))
+	for i := range tally {
+		ct, err := parseCiphertext(tally[i])
+		if err != nil {
+			return nil, err
+		}
+
+		as := big.NewInt(1)
+		for j := range combined {
+			if len(combined[j].Shares()) != len(tally) {
+				return nil, errors.Errorf("shares not matched with tally, %s, %d != %d", combined[j].Member(), len(combined[j].Shares()), len(tally))
+			}
+
+			d, err := parseGroupElement(combined[j].Shares()[i])
+			if err != nil {
+				return nil, err
+			}
+
+			as = mulP(as, expP(d, lagrangeCoefficient(indices[j], indices)))
+		}
+
+		decrypted[i] = divP(ct.b, as)
+	}
+
+	for i := range shares {
+		if result := shares[i].Result(); len(result) > 0 && matchDecrypted(decrypted, result) {
+			return result, nil
+		}
+	}
+
+	return nil, errors.Errorf("no claimed result matched with the decrypted tally")
+}
+
+func matchDecrypted(decrypted []*big.Int, result map[uint8]common.Big) bool {
+	for o := range result {
+		if int(o) >= len(decrypted) {
+			return false
+		}
+	}
+
+	for i := range decrypted {
+		m := big.NewInt(0)
+		if am, found := result[uint8(i)]; found {
+			m = am.Int
+		}
+
+		if m.Sign() < 0 || m.Cmp(elGamalQ) >= 0 || expP(elGamalG, m).Cmp(decrypted[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isValidResult(result map[uint8]common.Big) error {
+	for o, am := range result {
+		if err := am.IsValid(nil); err != nil {
+			return err
+		}
+
+		if am.Int.Sign() < 0 {
+			return errors.Errorf("negative result of option %d, %q", o, am)
+		}
+	}
+
+	return nil
+}
+
+func resultBytes(result map[uint8]common.Big) []byte {
+	options := make([]int, 0, len(result))
+	for o := range result {
+		options = append(options, int(o))
+	}
+	sort.Ints(options)
+
+	bs := make([][]byte, len(options))
+	for i, o := range options {
+		bs[i] = util.ConcatBytesSlice(util.Uint8ToBytes(uint8(o)), result[uint8(o)].Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (ds DecryptionShare) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  ds.Hint().String(),
+			"member": ds.member,
+			"shares": ds.shares,
+			"result": allocationsToStrings(ds.result),
+		},
+	)
+}
+
+type DecryptionShareBSONUnmarshaler struct {
+	Hint   string           `bson:"_hint"`
+	Member string           `bson:"member"`
+	Shares []string         `bson:"shares"`
+	Result map[uint8]string `bson:"result"`
+}
+
+func (ds *DecryptionShare) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DecryptionShare")
+
+	var u DecryptionShareBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return ds.unpack(enc, ht, u.Member, u.Shares, u.Result)
+}
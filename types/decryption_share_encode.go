@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (ds *DecryptionShare) unpack(enc encoder.Encoder, ht hint.Hint, mb string, shs []string, rs map[uint8]string) error {
+	e := util.StringError("failed to unmarshal DecryptionShare")
+
+	ds.BaseHinter = hint.NewBaseHinter(ht)
+	ds.shares = shs
+
+	switch a, err := base.DecodeAddress(mb, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		ds.member = a
+	}
+
+	result, err := allocationsFromStrings(rs)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	ds.result = result
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type DecryptionShareJSONMarshaler struct {
+	hint.BaseHinter
+	Member base.Address     `json:"member"`
+	Shares []string         `json:"shares"`
+	Result map[uint8]string `json:"result,omitempty"`
+}
+
+func (ds DecryptionShare) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DecryptionShareJSONMarshaler{
+		BaseHinter: ds.BaseHinter,
+		Member:     ds.member,
+		Shares:     ds.shares,
+		Result:     allocationsToStrings(ds.result),
+	})
+}
+
+type DecryptionShareJSONUnmarshaler struct {
+	Hint   hint.Hint        `json:"_hint"`
+	Member string           `json:"member"`
+	Shares []string         `json:"shares"`
+	Result map[uint8]string `json:"result"`
+}
+
+func (ds *DecryptionShare) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of DecryptionShare")
+
+	var u DecryptionShareJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return ds.unpack(enc, u.Hint, u.Member, u.Shares, u.Result)
+}
@@ -0,0 +1,268 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+const testProposalID = "proposal0"
+
+// newTestTallyCommittee returns the tally committee of n members with the key
+// shares of the members.
+func newTestTallyCommittee(t *testing.T, n, threshold int) (TallyCommittee, []string) {
+	t.Helper()
+
+	secret, shares := dealKeyShares(t, n, threshold)
+
+	members := make([]base.Address, n)
+	verificationKeys := make([]string, n)
+	secretShares := make([]string, n)
+	for i := range shares {
+		members[i] = base.NewStringAddress(fmt.Sprintf("member%d", i))
+		verificationKeys[i] = expP(elGamalG, shares[i]).Text(16)
+		secretShares[i] = shares[i].Text(16)
+	}
+
+	return NewTallyCommittee(members, verificationKeys, expP(elGamalG, secret).Text(16), uint(threshold)), secretShares
+}
+
+// encryptTestTally encrypts the votes of the weights and returns the encrypted
+// tally of the options.
+func encryptTestTally(t *testing.T, publicKey string, options uint8, votes []uint8, weights []int64) []string {
+	t.Helper()
+
+	tally := make([]ciphertext, options)
+	for i := range tally {
+		tally[i] = identityCiphertext()
+	}
+
+	for i, vote := range votes {
+		context := EncryptedBallotContext(base.NewStringAddress(fmt.Sprintf("voter%d", i)), testProposalID)
+
+		eb, err := EncryptBallot(publicKey, options, vote, context)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := eb.Verify(publicKey, options, context); err != nil {
+			t.Fatal(err)
+		}
+
+		for j, s := range eb.Ciphertexts() {
+			ct, err := parseCiphertext(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tally[j] = tally[j].mul(ct.exp(big.NewInt(weights[i])))
+		}
+	}
+
+	l := make([]string, len(tally))
+	for i := range tally {
+		l[i] = tally[i].String()
+	}
+
+	return l
+}
+
+func createTestDecryptionShare(
+	t *testing.T, committee TallyCommittee, secretShares []string, member int, tally []string, result map[uint8]common.Big,
+) DecryptionShare {
+	t.Helper()
+
+	ac := committee.Members()[member]
+	context := DecryptionShareContext(ac, testProposalID)
+
+	shares, proofs, err := CreateDecryptionShares(secretShares[member], tally, context)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyDecryptionShares(committee.VerificationKeys()[member], tally, shares, proofs, context); err != nil {
+		t.Fatalf("member %d: %v", member, err)
+	}
+
+	return NewDecryptionShare(ac, shares, result)
+}
+
+func TestVerifyDecryptedResult(t *testing.T) {
+	committee, secretShares := newTestTallyCommittee(t, 4, 3)
+	tally := encryptTestTally(t, committee.PublicKey(), 3, []uint8{0, 2, 0, 1}, []int64{5, 3, 2, 7})
+
+	result := map[uint8]common.Big{0: common.NewBig(7), 1: common.NewBig(7), 2: common.NewBig(3)}
+	wrong := map[uint8]common.Big{0: common.NewBig(7), 1: common.NewBig(3), 2: common.NewBig(7)}
+
+	cases := []struct {
+		name    string
+		members []int
+		results []map[uint8]common.Big
+		err     bool
+	}{
+		{name: "first members", members: []int{0, 1, 2}, results: []map[uint8]common.Big{result, nil, nil}},
+		{name: "last members", members: []int{1, 2, 3}, results: []map[uint8]common.Big{nil, nil, result}},
+		{name: "all members", members: []int{3, 0, 2, 1}, results: []map[uint8]common.Big{nil, result, nil, nil}},
+		{name: "wrong claim skipped", members: []int{0, 2, 3}, results: []map[uint8]common.Big{wrong, nil, result}},
+		{name: "wrong claim", members: []int{0, 1, 2}, results: []map[uint8]common.Big{wrong, nil, nil}, err: true},
+		{name: "no claim", members: []int{0, 1, 2}, results: []map[uint8]common.Big{nil, nil, nil}, err: true},
+		{name: "under threshold", members: []int{0, 1}, results: []map[uint8]common.Big{result, result}, err: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			shares := make([]DecryptionShare, len(c.members))
+			for i, member := range c.members {
+				shares[i] = createTestDecryptionShare(t, committee, secretShares, member, tally, c.results[i])
+			}
+
+			decrypted, err := VerifyDecryptedResult(committee, tally, shares)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !matchResult(decrypted, result) {
+				t.Fatalf("expected %v, got %v", result, decrypted)
+			}
+		})
+	}
+}
+
+func TestVerifyDecryptedResultUnderThreshold(t *testing.T) {
+	committee, secretShares := newTestTallyCommittee(t, 4, 3)
+	tally := encryptTestTally(t, committee.PublicKey(), 2, []uint8{0, 1, 0}, []int64{4, 1, 2})
+	result := map[uint8]common.Big{0: common.NewBig(6), 1: common.NewBig(1)}
+
+	// the shares of the members under the threshold do not decrypt the tally
+	// even combined as the shares of a committee of the lower threshold.
+	lower := NewTallyCommittee(committee.Members(), committee.VerificationKeys(), committee.PublicKey(), 2)
+
+	shares := []DecryptionShare{
+		createTestDecryptionShare(t, committee, secretShares, 0, tally, result),
+		createTestDecryptionShare(t, committee, secretShares, 3, tally, result),
+	}
+
+	if _, err := VerifyDecryptedResult(lower, tally, shares); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestVerifyDecryptionSharesTampered(t *testing.T) {
+	committee, secretShares := newTestTallyCommittee(t, 3, 2)
+	tally := encryptTestTally(t, committee.PublicKey(), 2, []uint8{1, 0}, []int64{3, 2})
+
+	ac := committee.Members()[0]
+	context := DecryptionShareContext(ac, testProposalID)
+	vk := committee.VerificationKeys()[0]
+
+	shares, proofs, err := CreateDecryptionShares(secretShares[0], tally, context)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyDecryptionShares(vk, tally, shares, proofs, context); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := parseGroupElement(shares[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := []string{shares[0], mulP(d, elGamalG).Text(16)}
+	if err := VerifyDecryptionShares(vk, tally, tampered, proofs, context); err == nil {
+		t.Fatal("tampered share: expected error")
+	}
+
+	swapped := []string{shares[1], shares[0]}
+	if err := VerifyDecryptionShares(vk, tally, swapped, proofs, context); err == nil {
+		t.Fatal("swapped shares: expected error")
+	}
+
+	if err := VerifyDecryptionShares(committee.VerificationKeys()[1], tally, shares, proofs, context); err == nil {
+		t.Fatal("verification key of another member: expected error")
+	}
+
+	if err := VerifyDecryptionShares(vk, tally, shares, proofs, DecryptionShareContext(committee.Members()[1], testProposalID)); err == nil {
+		t.Fatal("context of another member: expected error")
+	}
+
+	if err := VerifyDecryptionShares(vk, tally, shares[:1], proofs[:1], context); err == nil {
+		t.Fatal("shares not matched with tally: expected error")
+	}
+}
+
+func TestEncryptedTallyWeightUnit(t *testing.T) {
+	committee, secretShares := newTestTallyCommittee(t, 3, 2)
+
+	votingPowers := map[string]VotingPower{}
+	for i, v := range []struct {
+		weight int64
+		vote   uint8
+	}{{weight: 3000000000, vote: 0}, {weight: 2000000001, vote: 1}, {weight: 1, vote: 0}} {
+		ac := base.NewStringAddress(fmt.Sprintf("voter%d", i))
+
+		eb, err := EncryptBallot(committee.PublicKey(), 3, v.vote, EncryptedBallotContext(ac, testProposalID))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vp := NewVotingPower(ac, common.NewBig(v.weight))
+		vp.SetVoted(true)
+		vp.SetCiphertexts(eb.Ciphertexts())
+		votingPowers[ac.String()] = vp
+	}
+
+	vpb := NewVotingPowerBox(common.NewBig(5000000002), votingPowers)
+
+	if unit := vpb.EncryptedWeightUnit(); !unit.Equal(common.NewBig(2)) {
+		t.Fatalf("expected unit 2, got %v", unit)
+	}
+
+	tally, err := vpb.EncryptedTally(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := map[uint8]common.Big{0: common.NewBig(1500000000), 1: common.NewBig(1000000000)}
+	raw := map[uint8]common.Big{0: common.NewBig(3000000001), 1: common.NewBig(2000000001)}
+
+	shares := []DecryptionShare{
+		createTestDecryptionShare(t, committee, secretShares, 0, tally, raw),
+		createTestDecryptionShare(t, committee, secretShares, 2, tally, result),
+	}
+
+	decrypted, err := VerifyDecryptedResult(committee, tally, shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !matchResult(decrypted, result) {
+		t.Fatalf("expected %v, got %v", result, decrypted)
+	}
+}
+
+func matchResult(a, b map[uint8]common.Big) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for o, am := range a {
+		if bm, found := b[o]; !found || !am.Equal(bm) {
+			return false
+		}
+	}
+
+	return true
+}
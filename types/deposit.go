@@ -0,0 +1,89 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var DepositHint = hint.MustNewHint("mitum-dao-deposit-v0.0.1")
+
+// Deposit is the threshold amount escrowed under the dao contract by the
+// proposer. The deposit holds the amount apart from the contract balance until
+// it is refunded when the proposal passes or slashed into the dao contract
+// treasury when the proposal is rejected or canceled for low or no turnout.
+type Deposit struct {
+	hint.BaseHinter
+	proposer base.Address
+	currency currencytypes.CurrencyID
+	amount   common.Big
+	status   DepositStatus
+}
+
+func NewDeposit(proposer base.Address, currency currencytypes.CurrencyID, amount common.Big, status DepositStatus) Deposit {
+	return Deposit{
+		BaseHinter: hint.NewBaseHinter(DepositHint),
+		proposer:   proposer,
+		currency:   currency,
+		amount:     amount,
+		status:     status,
+	}
+}
+
+func (d Deposit) Hint() hint.Hint {
+	return d.BaseHinter.Hint()
+}
+
+func (d Deposit) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid Deposit")
+
+	if err := d.BaseHinter.IsValid(DepositHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, d.proposer, d.currency, d.amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	if d.amount.Compare(common.ZeroBig) < 0 {
+		return e.Wrap(errors.Errorf("negative deposit amount, %v", d.amount))
+	}
+
+	if d.status >= NilDepositStatus {
+		return e.Wrap(errors.Errorf("unknown deposit status, %d", d.status))
+	}
+
+	return nil
+}
+
+func (d Deposit) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		d.proposer.Bytes(),
+		d.currency.Bytes(),
+		d.amount.Bytes(),
+		d.status.Bytes(),
+	)
+}
+
+func (d Deposit) Proposer() base.Address {
+	return d.proposer
+}
+
+func (d Deposit) Currency() currencytypes.CurrencyID {
+	return d.currency
+}
+
+func (d Deposit) Amount() common.Big {
+	return d.amount
+}
+
+func (d Deposit) Status() DepositStatus {
+	return d.status
+}
+
+func (d *Deposit) SetStatus(status DepositStatus) {
+	d.status = status
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (d Deposit) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":    d.Hint().String(),
+			"proposer": d.proposer,
+			"currency": d.currency,
+			"amount":   d.amount.String(),
+			"status":   d.status,
+		},
+	)
+}
+
+type DepositBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Proposer string `bson:"proposer"`
+	Currency string `bson:"currency"`
+	Amount   string `bson:"amount"`
+	Status   uint8  `bson:"status"`
+}
+
+func (d *Deposit) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Deposit")
+
+	var u DepositBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return d.unpack(enc, ht, u.Proposer, u.Currency, u.Amount, u.Status)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (d *Deposit) unpack(enc encoder.Encoder, ht hint.Hint, pr, cr, am string, st uint8) error {
+	e := util.StringError("failed to unmarshal Deposit")
+
+	d.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(pr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		d.proposer = a
+	}
+
+	d.currency = currencytypes.CurrencyID(cr)
+
+	big, err := common.NewBigFromString(am)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	d.amount = big
+	d.status = DepositStatus(st)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type DepositJSONMarshaler struct {
+	hint.BaseHinter
+	Proposer base.Address             `json:"proposer"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+	Amount   string                   `json:"amount"`
+	Status   DepositStatus            `json:"status"`
+}
+
+func (d Deposit) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DepositJSONMarshaler{
+		BaseHinter: d.BaseHinter,
+		Proposer:   d.proposer,
+		Currency:   d.currency,
+		Amount:     d.amount.String(),
+		Status:     d.status,
+	})
+}
+
+type DepositJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Proposer string    `json:"proposer"`
+	Currency string    `json:"currency"`
+	Amount   string    `json:"amount"`
+	Status   uint8     `json:"status"`
+}
+
+func (d *Deposit) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of Deposit")
+
+	var u DepositJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return d.unpack(enc, u.Hint, u.Proposer, u.Currency, u.Amount, u.Status)
+}
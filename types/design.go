@@ -8,11 +8,12 @@ import (
 type DAOOption string
 
 func (op DAOOption) IsValid([]byte) error {
-	if op != "crypto" && op != "biz" {
-		return util.ErrInvalid.Errorf("invalid dao option; 'crypto' | 'biz'")
+	switch op {
+	case ProposalCrypto, ProposalBiz, ProposalHybrid:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid dao option; 'crypto' | 'biz' | 'hybrid'")
 	}
-
-	return nil
 }
 
 func (op DAOOption) Bytes() []byte {
@@ -0,0 +1,267 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Encrypted ballots are encrypted by the exponential ElGamal encryption over
+// the 2048-bit MODP group of RFC 3526. The generator g generates the subgroup
+// of the prime order q, so the product of the ciphertexts of the votes is the
+// ciphertext of the sum of the votes and only the sums are decrypted by the
+// tally committee.
+var (
+	elGamalP, _ = new(big.Int).SetString(
+		"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD129024E088A67CC74"+
+			"020BBEA63B139B22514A08798E3404DDEF9519B3CD3A431B302B0A6DF25F1437"+
+			"4FE1356D6D51C245E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+			"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3DC2007CB8A163BF05"+
+			"98DA48361C55D39A69163FA8FD24CF5F83655D23DCA3AD961C62F356208552BB"+
+			"9ED529077096966D670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B"+
+			"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9DE2BCBF695581718"+
+			"3995497CEA956AE515D2261898FA051015728E5A8AACAA68FFFFFFFFFFFFFFFF",
+		16,
+	)
+	elGamalQ = new(big.Int).Rsh(elGamalP, 1)
+	elGamalG = big.NewInt(2)
+	bigOne   = big.NewInt(1)
+)
+
+func expP(b, e *big.Int) *big.Int {
+	return new(big.Int).Exp(b, e, elGamalP)
+}
+
+func mulP(a, b *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+
+	return r.Mod(r, elGamalP)
+}
+
+func divP(a, b *big.Int) *big.Int {
+	return mulP(a, new(big.Int).ModInverse(b, elGamalP))
+}
+
+func modQ(a *big.Int) *big.Int {
+	return a.Mod(a, elGamalQ)
+}
+
+func randomScalar() (*big.Int, error) {
+	return rand.Int(rand.Reader, elGamalQ)
+}
+
+// parseGroupElement parses the hex string of an element of the subgroup.
+func parseGroupElement(s string) (*big.Int, error) {
+	x, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return nil, errors.Errorf("invalid group element, %q", s)
+	}
+
+	if x.Sign() < 1 || x.Cmp(elGamalP) >= 0 || expP(x, elGamalQ).Cmp(bigOne) != 0 {
+		return nil, errors.Errorf("not a group element, %q", s)
+	}
+
+	return x, nil
+}
+
+// parseScalar parses the hex string of an exponent of the group.
+func parseScalar(s string) (*big.Int, error) {
+	x, ok := new(big.Int).SetString(s, 16)
+	if !ok || x.Sign() < 0 || x.Cmp(elGamalQ) >= 0 {
+		return nil, errors.Errorf("invalid scalar, %q", s)
+	}
+
+	return x, nil
+}
+
+func parseScalars(s string, n int) ([]*big.Int, error) {
+	l := strings.Split(s, ":")
+	if len(l) != n {
+		return nil, errors.Errorf("expected %d scalars, not %d", n, len(l))
+	}
+
+	xs := make([]*big.Int, n)
+	for i := range l {
+		x, err := parseScalar(l[i])
+		if err != nil {
+			return nil, err
+		}
+
+		xs[i] = x
+	}
+
+	return xs, nil
+}
+
+func joinScalars(xs ...*big.Int) string {
+	l := make([]string, len(xs))
+	for i := range xs {
+		l[i] = xs[i].Text(16)
+	}
+
+	return strings.Join(l, ":")
+}
+
+// challenge is the Fiat-Shamir challenge of the proofs bound to the context.
+func challenge(context []byte, elements ...*big.Int) *big.Int {
+	h := sha256.New()
+	_, _ = h.Write(context)
+
+	for i := range elements {
+		_, _ = h.Write(elements[i].FillBytes(make([]byte, 256)))
+	}
+
+	return modQ(new(big.Int).SetBytes(h.Sum(nil)))
+}
+
+type ciphertext struct {
+	a *big.Int
+	b *big.Int
+}
+
+func identityCiphertext() ciphertext {
+	return ciphertext{a: big.NewInt(1), b: big.NewInt(1)}
+}
+
+func encrypt(publicKey, m, r *big.Int) ciphertext {
+	return ciphertext{
+		a: expP(elGamalG, r),
+		b: mulP(expP(elGamalG, m), expP(publicKey, r)),
+	}
+}
+
+func parseCiphertext(s string) (ciphertext, error) {
+	l := strings.Split(s, ":")
+	if len(l) != 2 {
+		return ciphertext{}, errors.Errorf("invalid ciphertext, %q", s)
+	}
+
+	a, err := parseGroupElement(l[0])
+	if err != nil {
+		return ciphertext{}, err
+	}
+
+	b, err := parseGroupElement(l[1])
+	if err != nil {
+		return ciphertext{}, err
+	}
+
+	return ciphertext{a: a, b: b}, nil
+}
+
+func (c ciphertext) String() string {
+	return c.a.Text(16) + ":" + c.b.Text(16)
+}
+
+func (c ciphertext) mul(d ciphertext) ciphertext {
+	return ciphertext{a: mulP(c.a, d.a), b: mulP(c.b, d.b)}
+}
+
+func (c ciphertext) exp(e *big.Int) ciphertext {
+	return ciphertext{a: expP(c.a, e), b: expP(c.b, e)}
+}
+
+// proveDLEQ proves log_g1 h1 == log_g2 h2 == x by the Chaum-Pedersen proof.
+func proveDLEQ(context []byte, x, g1, h1, g2, h2 *big.Int) (string, error) {
+	w, err := randomScalar()
+	if err != nil {
+		return "", err
+	}
+
+	c := challenge(context, g1, h1, g2, h2, expP(g1, w), expP(g2, w))
+	r := modQ(new(big.Int).Sub(w, new(big.Int).Mul(c, x)))
+
+	return joinScalars(c, r), nil
+}
+
+func verifyDLEQ(context []byte, proof string, g1, h1, g2, h2 *big.Int) error {
+	xs, err := parseScalars(proof, 2)
+	if err != nil {
+		return err
+	}
+	c, r := xs[0], xs[1]
+
+	a1 := mulP(expP(g1, r), expP(h1, c))
+	a2 := mulP(expP(g2, r), expP(h2, c))
+
+	if challenge(context, g1, h1, g2, h2, a1, a2).Cmp(c) != 0 {
+		return errors.Errorf("invalid equality proof")
+	}
+
+	return nil
+}
+
+// proveBit proves the ciphertext encrypts 0 or 1 without telling which by the
+// disjunction of the Chaum-Pedersen proofs of the two cases.
+func proveBit(context []byte, publicKey *big.Int, ct ciphertext, m int, r *big.Int) (string, error) {
+	bs := [2]*big.Int{ct.b, divP(ct.b, elGamalG)}
+
+	var cs, rs, as, hs [2]*big.Int
+
+	f := 1 - m
+	for _, p := range []**big.Int{&cs[f], &rs[f]} {
+		x, err := randomScalar()
+		if err != nil {
+			return "", err
+		}
+		*p = x
+	}
+	as[f] = mulP(expP(elGamalG, rs[f]), expP(ct.a, cs[f]))
+	hs[f] = mulP(expP(publicKey, rs[f]), expP(bs[f], cs[f]))
+
+	w, err := randomScalar()
+	if err != nil {
+		return "", err
+	}
+	as[m] = expP(elGamalG, w)
+	hs[m] = expP(publicKey, w)
+
+	c := challenge(context, ct.a, ct.b, as[0], hs[0], as[1], hs[1])
+	cs[m] = modQ(new(big.Int).Sub(c, cs[f]))
+	rs[m] = modQ(new(big.Int).Sub(w, new(big.Int).Mul(cs[m], r)))
+
+	return joinScalars(cs[0], cs[1], rs[0], rs[1]), nil
+}
+
+func verifyBit(context []byte, publicKey *big.Int, ct ciphertext, proof string) error {
+	xs, err := parseScalars(proof, 4)
+	if err != nil {
+		return err
+	}
+
+	bs := [2]*big.Int{ct.b, divP(ct.b, elGamalG)}
+
+	var as, hs [2]*big.Int
+	for i := range bs {
+		c, r := xs[i], xs[i+2]
+		as[i] = mulP(expP(elGamalG, r), expP(ct.a, c))
+		hs[i] = mulP(expP(publicKey, r), expP(bs[i], c))
+	}
+
+	c := modQ(new(big.Int).Add(xs[0], xs[1]))
+	if challenge(context, ct.a, ct.b, as[0], hs[0], as[1], hs[1]).Cmp(c) != 0 {
+		return errors.Errorf("invalid bit proof")
+	}
+
+	return nil
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient at zero of the share of
+// the index among the indices of the combined shares.
+func lagrangeCoefficient(index uint64, indices []uint64) *big.Int {
+	num, den := big.NewInt(1), big.NewInt(1)
+
+	for _, k := range indices {
+		if k == index {
+			continue
+		}
+
+		num = modQ(num.Mul(num, new(big.Int).SetUint64(k)))
+		den = modQ(den.Mul(den, new(big.Int).Sub(new(big.Int).SetUint64(k), new(big.Int).SetUint64(index))))
+	}
+
+	return modQ(num.Mul(num, new(big.Int).ModInverse(den, elGamalQ)))
+}
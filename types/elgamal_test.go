@@ -0,0 +1,190 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+// dealKeyShares deals the key shares of n members by a random polynomial of
+// the degree threshold-1 and returns the secret key with the shares of the
+// indices 1 to n.
+func dealKeyShares(t *testing.T, n, threshold int) (*big.Int, []*big.Int) {
+	t.Helper()
+
+	coefficients := make([]*big.Int, threshold)
+	for i := range coefficients {
+		x, err := randomScalar()
+		if err != nil {
+			t.Fatal(err)
+		}
+		coefficients[i] = x
+	}
+
+	shares := make([]*big.Int, n)
+	for i := range shares {
+		x, s := big.NewInt(int64(i+1)), big.NewInt(0)
+		for j := len(coefficients) - 1; j >= 0; j-- {
+			s = modQ(s.Add(s.Mul(s, x), coefficients[j]))
+		}
+		shares[i] = s
+	}
+
+	return coefficients[0], shares
+}
+
+func TestEncryptedBallot(t *testing.T) {
+	secret, err := randomScalar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey := expP(elGamalG, secret).Text(16)
+	context := []byte("voter0proposal0")
+
+	eb, err := EncryptBallot(publicKey, 3, 1, context)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := eb.Verify(publicKey, 3, context); err != nil {
+		t.Fatalf("valid ballot: %v", err)
+	}
+
+	for i, ct := range eb.Ciphertexts() {
+		c, err := parseCiphertext(ct)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := 0
+		if i == 1 {
+			m = 1
+		}
+
+		if divP(c.b, expP(c.a, secret)).Cmp(expP(elGamalG, big.NewInt(int64(m)))) != 0 {
+			t.Fatalf("option %d: expected to decrypt %d", i, m)
+		}
+	}
+
+	if err := eb.Verify(publicKey, 3, []byte("voter1proposal0")); err == nil {
+		t.Fatal("ballot copied to another context: expected error")
+	}
+
+	if err := eb.Verify(publicKey, 4, context); err == nil {
+		t.Fatal("ballot of other options: expected error")
+	}
+
+	if _, err := EncryptBallot(publicKey, 3, 3, context); err == nil {
+		t.Fatal("vote out of options: expected error")
+	}
+}
+
+func TestProveBitOutOfRange(t *testing.T) {
+	secret, err := randomScalar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := expP(elGamalG, secret)
+	context := []byte("voter0proposal0")
+
+	for _, m := range []int{0, 1} {
+		r, err := randomScalar()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ct := encrypt(pk, big.NewInt(int64(m)), r)
+		proof, err := proveBit(context, pk, ct, m, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := verifyBit(context, pk, ct, proof); err != nil {
+			t.Fatalf("bit %d: %v", m, err)
+		}
+	}
+
+	for _, v := range []int64{2, -1, 5} {
+		for _, m := range []int{0, 1} {
+			r, err := randomScalar()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ct := encrypt(pk, modQ(big.NewInt(v)), r)
+			proof, err := proveBit(context, pk, ct, m, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := verifyBit(context, pk, ct, proof); err == nil {
+				t.Fatalf("%d proved as bit %d: expected error", v, m)
+			}
+		}
+	}
+}
+
+func TestEncryptedBallotSumOutOfRange(t *testing.T) {
+	secret, err := randomScalar()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := expP(elGamalG, secret)
+	publicKey := pk.Text(16)
+	context := []byte("voter0proposal0")
+
+	for _, votes := range [][]int{{1, 1, 0}, {0, 0, 0}} {
+		ciphertexts := make([]string, len(votes))
+		proofs := make([]string, len(votes))
+		sum, rsum := identityCiphertext(), big.NewInt(0)
+
+		for i, m := range votes {
+			r, err := randomScalar()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ct := encrypt(pk, big.NewInt(int64(m)), r)
+			proof, err := proveBit(context, pk, ct, m, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ciphertexts[i], proofs[i] = ct.String(), proof
+			sum, rsum = sum.mul(ct), modQ(rsum.Add(rsum, r))
+		}
+
+		sumProof, err := proveDLEQ(context, rsum, elGamalG, sum.a, pk, divP(sum.b, elGamalG))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := NewEncryptedBallot(ciphertexts, proofs, sumProof).Verify(publicKey, 3, context); err == nil {
+			t.Fatalf("votes %v: expected error", votes)
+		}
+	}
+}
+
+func TestLagrangeCoefficient(t *testing.T) {
+	secret, shares := dealKeyShares(t, 5, 3)
+
+	for _, indices := range [][]uint64{{1, 2, 3}, {2, 4, 5}, {1, 3, 5}, {1, 2, 3, 4}} {
+		s := big.NewInt(0)
+		for _, i := range indices {
+			s = modQ(s.Add(s, new(big.Int).Mul(shares[i-1], lagrangeCoefficient(i, indices))))
+		}
+
+		if s.Cmp(secret) != 0 {
+			t.Fatalf("indices %v: secret not interpolated", indices)
+		}
+	}
+
+	s := big.NewInt(0)
+	indices := []uint64{1, 2}
+	for _, i := range indices {
+		s = modQ(s.Add(s, new(big.Int).Mul(shares[i-1], lagrangeCoefficient(i, indices))))
+	}
+
+	if s.Cmp(secret) == 0 {
+		t.Fatal("secret interpolated under threshold")
+	}
+}
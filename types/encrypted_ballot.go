@@ -0,0 +1,247 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var EncryptedBallotHint = hint.MustNewHint("mitum-dao-encrypted-ballot-v0.0.1")
+
+// EncryptedBallot is a vote encrypted to the public key of the tally
+// committee. It holds a ciphertext of 1 for the voted option and 0 for the
+// others, the proofs that each ciphertext encrypts 0 or 1 and the proof that
+// the ciphertexts encrypt 1 in total.
+type EncryptedBallot struct {
+	hint.BaseHinter
+	ciphertexts []string
+	proofs      []string
+	sumProof    string
+}
+
+func NewEncryptedBallot(ciphertexts, proofs []string, sumProof string) EncryptedBallot {
+	return EncryptedBallot{
+		BaseHinter:  hint.NewBaseHinter(EncryptedBallotHint),
+		ciphertexts: ciphertexts,
+		proofs:      proofs,
+		sumProof:    sumProof,
+	}
+}
+
+// EncryptBallot encrypts the vote for one of the options to the public key.
+// The context binds the proofs to the voter and the proposal, see
+// EncryptedBallotContext.
+func EncryptBallot(publicKey string, options, vote uint8, context []byte) (EncryptedBallot, error) {
+	e := util.StringError("failed to encrypt ballot")
+
+	if vote >= options {
+		return EncryptedBallot{}, e.Errorf("option out of range, %d >= %d", vote, options)
+	}
+
+	pk, err := parseGroupElement(publicKey)
+	if err != nil {
+		return EncryptedBallot{}, e.Wrap(err)
+	}
+
+	ciphertexts := make([]string, options)
+	proofs := make([]string, options)
+	sum, rsum := identityCiphertext(), big.NewInt(0)
+
+	for i := uint8(0); i < options; i++ {
+		m := 0
+		if i == vote {
+			m = 1
+		}
+
+		r, err := randomScalar()
+		if err != nil {
+			return EncryptedBallot{}, e.Wrap(err)
+		}
+
+		ct := encrypt(pk, big.NewInt(int64(m)), r)
+		proof, err := proveBit(context, pk, ct, m, r)
+		if err != nil {
+			return EncryptedBallot{}, e.Wrap(err)
+		}
+
+		ciphertexts[i], proofs[i] = ct.String(), proof
+		sum, rsum = sum.mul(ct), modQ(rsum.Add(rsum, r))
+	}
+
+	sumProof, err := proveDLEQ(context, rsum, elGamalG, sum.a, pk, divP(sum.b, elGamalG))
+	if err != nil {
+		return EncryptedBallot{}, e.Wrap(err)
+	}
+
+	return NewEncryptedBallot(ciphertexts, proofs, sumProof), nil
+}
+
+// EncryptedBallotContext returns the context of the proofs of the encrypted
+// ballot of the voter, so the ballot of another voter can not be copied.
+func EncryptedBallotContext(voter base.Address, proposalID string) []byte {
+	return util.ConcatBytesSlice(voter.Bytes(), []byte(proposalID))
+}
+
+func (eb EncryptedBallot) Hint() hint.Hint {
+	return eb.BaseHinter.Hint()
+}
+
+func (eb EncryptedBallot) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid EncryptedBallot")
+
+	if err := eb.BaseHinter.IsValid(EncryptedBallotHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(eb.ciphertexts) < 1 {
+		return e.Wrap(errors.Errorf("empty ciphertexts"))
+	}
+
+	if len(eb.ciphertexts) != len(eb.proofs) {
+		return e.Wrap(errors.Errorf("proofs not matched with ciphertexts, %d != %d", len(eb.proofs), len(eb.ciphertexts)))
+	}
+
+	if len(eb.sumProof) < 1 {
+		return e.Wrap(errors.Errorf("empty sum proof"))
+	}
+
+	return nil
+}
+
+func (eb EncryptedBallot) Bytes() []byte {
+	bs := make([][]byte, len(eb.ciphertexts)+len(eb.proofs)+1)
+	for i := range eb.ciphertexts {
+		bs[i] = []byte(eb.ciphertexts[i])
+	}
+
+	for i := range eb.proofs {
+		bs[len(eb.ciphertexts)+i] = []byte(eb.proofs[i])
+	}
+	bs[len(bs)-1] = []byte(eb.sumProof)
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// Ciphertexts returns the ciphertexts of the options.
+func (eb EncryptedBallot) Ciphertexts() []string {
+	return eb.ciphertexts
+}
+
+func (eb EncryptedBallot) Proofs() []string {
+	return eb.proofs
+}
+
+func (eb EncryptedBallot) SumProof() string {
+	return eb.sumProof
+}
+
+// Verify verifies the ballot encrypts a vote for one of the options to the
+// public key.
+func (eb EncryptedBallot) Verify(publicKey string, options uint8, context []byte) error {
+	if len(eb.ciphertexts) != int(options) {
+		return errors.Errorf("ciphertexts not matched with options, %d != %d", len(eb.ciphertexts), options)
+	}
+
+	pk, err := parseGroupElement(publicKey)
+	if err != nil {
+		return err
+	}
+
+	sum := identityCiphertext()
+	for i := range eb.ciphertexts {
+		ct, err := parseCiphertext(eb.ciphertexts[i])
+		if err != nil {
+			return errors.Wrapf(err, "option %d", i)
+		}
+
+		if err := verifyBit(context, pk, ct, eb.proofs[i]); err != nil {
+			return errors.Wrapf(err, "option %d", i)
+		}
+
+		sum = sum.mul(ct)
+	}
+
+	return verifyDLEQ(context, eb.sumProof, elGamalG, sum.a, pk, divP(sum.b, elGamalG))
+}
+
+// EncryptedTallyBound bounds the decrypted tally of an option, so the tally
+// committee can solve its discrete logarithm.
+const EncryptedTallyBound = 1 << 32
+
+// EncryptedWeightUnit returns the unit the effective weights of the encrypted
+// votes are counted in; the smallest unit keeping the sum of all the weights of
+// the voting power box under EncryptedTallyBound.
+func (vp VotingPowerBox) EncryptedWeightUnit() common.Big {
+	total := vp.TotalWeight()
+
+	bound := common.NewBig(EncryptedTallyBound)
+	if total.Compare(bound) < 0 {
+		return common.NewBig(1)
+	}
+
+	return total.Div(bound).Add(common.NewBig(1))
+}
+
+// EncryptedTally returns the ciphertexts of the sums of the encrypted votes for
+// each option weighted by the effective weights of the voters in the
+// EncryptedWeightUnit. The remainders of the weights under the unit are not
+// counted.
+func (vp VotingPowerBox) EncryptedTally(options uint8) ([]string, error) {
+	cts, err := vp.encryptedTally(options)
+	if err != nil {
+		return nil, err
+	}
+
+	tally := make([]string, len(cts))
+	for i := range cts {
+		tally[i] = cts[i].String()
+	}
+
+	return tally, nil
+}
+
+func (vp VotingPowerBox) encryptedTally(options uint8) ([]ciphertext, error) {
+	tally := make([]ciphertext, options)
+	for i := range tally {
+		tally[i] = identityCiphertext()
+	}
+
+	unit := vp.EncryptedWeightUnit()
+
+	for k, v := range vp.votingPowers {
+		if !v.Encrypted() {
+			continue
+		}
+
+		if len(v.ciphertexts) != int(options) {
+			return nil, errors.Errorf("ciphertexts not matched with options, %s, %d != %d", k, len(v.ciphertexts), options)
+		}
+
+		for i := range v.ciphertexts {
+			ct, err := parseCiphertext(v.ciphertexts[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "voter %s, option %d", k, i)
+			}
+
+			tally[i] = tally[i].mul(ct.exp(v.weight.Div(unit).Int))
+		}
+	}
+
+	return tally, nil
+}
+
+// EncryptedVoted returns the sum of the effective weights of the encrypted votes.
+func (vp VotingPowerBox) EncryptedVoted() common.Big {
+	total := common.ZeroBig
+	for _, v := range vp.votingPowers {
+		if v.Encrypted() {
+			total = total.Add(v.Weight())
+		}
+	}
+
+	return total
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (eb EncryptedBallot) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       eb.Hint().String(),
+			"ciphertexts": eb.ciphertexts,
+			"proofs":      eb.proofs,
+			"sum_proof":   eb.sumProof,
+		},
+	)
+}
+
+type EncryptedBallotBSONUnmarshaler struct {
+	Hint        string   `bson:"_hint"`
+	Ciphertexts []string `bson:"ciphertexts"`
+	Proofs      []string `bson:"proofs"`
+	SumProof    string   `bson:"sum_proof"`
+}
+
+func (eb *EncryptedBallot) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of EncryptedBallot")
+
+	var u EncryptedBallotBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return eb.unpack(enc, ht, u.Ciphertexts, u.Proofs, u.SumProof)
+}
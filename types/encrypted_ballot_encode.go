@@ -0,0 +1,15 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (eb *EncryptedBallot) unpack(_ encoder.Encoder, ht hint.Hint, cts, pfs []string, sp string) error {
+	eb.BaseHinter = hint.NewBaseHinter(ht)
+	eb.ciphertexts = cts
+	eb.proofs = pfs
+	eb.sumProof = sp
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type EncryptedBallotJSONMarshaler struct {
+	hint.BaseHinter
+	Ciphertexts []string `json:"ciphertexts"`
+	Proofs      []string `json:"proofs"`
+	SumProof    string   `json:"sum_proof"`
+}
+
+func (eb EncryptedBallot) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(EncryptedBallotJSONMarshaler{
+		BaseHinter:  eb.BaseHinter,
+		Ciphertexts: eb.ciphertexts,
+		Proofs:      eb.proofs,
+		SumProof:    eb.sumProof,
+	})
+}
+
+type EncryptedBallotJSONUnmarshaler struct {
+	Hint        hint.Hint `json:"_hint"`
+	Ciphertexts []string  `json:"ciphertexts"`
+	Proofs      []string  `json:"proofs"`
+	SumProof    string    `json:"sum_proof"`
+}
+
+func (eb *EncryptedBallot) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of EncryptedBallot")
+
+	var u EncryptedBallotJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return eb.unpack(enc, u.Hint, u.Ciphertexts, u.Proofs, u.SumProof)
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var LockInfoHint = hint.MustNewHint("mitum-dao-lock-info-v0.0.1")
+
+// LockInfo is the voting power token balance escrowed under the dao contract by an account.
+// The lock holds the amount apart from the contract balance, so the treasury can not spend it.
+// proposals lists the proposals which use the locked amount as voting power.
+type LockInfo struct {
+	hint.BaseHinter
+	account   base.Address
+	currency  currencytypes.CurrencyID
+	amount    common.Big
+	proposals []string
+}
+
+func NewLockInfo(account base.Address, currency currencytypes.CurrencyID, amount common.Big, proposals []string) LockInfo {
+	return LockInfo{
+		BaseHinter: hint.NewBaseHinter(LockInfoHint),
+		account:    account,
+		currency:   currency,
+		amount:     amount,
+		proposals:  proposals,
+	}
+}
+
+func (l LockInfo) Hint() hint.Hint {
+	return l.BaseHinter.Hint()
+}
+
+func (l LockInfo) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid LockInfo")
+
+	if err := l.BaseHinter.IsValid(LockInfoHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, l.account, l.currency, l.amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, pid := range l.proposals {
+		if len(pid) == 0 {
+			return e.Wrap(errors.Errorf("empty proposal id"))
+		}
+
+		if _, found := founds[pid]; found {
+			return e.Wrap(errors.Errorf("duplicate proposal id found, %q", pid))
+		}
+
+		founds[pid] = struct{}{}
+	}
+
+	return nil
+}
+
+func (l LockInfo) Bytes() []byte {
+	ba := make([][]byte, len(l.proposals)+3)
+
+	ba[0] = l.account.Bytes()
+	ba[1] = l.currency.Bytes()
+	ba[2] = l.amount.Bytes()
+
+	for i, pid := range l.proposals {
+		ba[i+3] = []byte(pid)
+	}
+
+	return util.ConcatBytesSlice(ba...)
+}
+
+func (l LockInfo) Account() base.Address {
+	return l.account
+}
+
+func (l LockInfo) Currency() currencytypes.CurrencyID {
+	return l.currency
+}
+
+func (l LockInfo) Amount() common.Big {
+	return l.amount
+}
+
+func (l *LockInfo) SetAmount(amount common.Big) {
+	l.amount = amount
+}
+
+func (l LockInfo) Proposals() []string {
+	return l.proposals
+}
+
+func (l *LockInfo) SetProposals(proposals []string) {
+	l.proposals = proposals
+}
+
+func (l LockInfo) HasProposal(pid string) bool {
+	for _, p := range l.proposals {
+		if p == pid {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (l LockInfo) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     l.Hint().String(),
+			"account":   l.account,
+			"currency":  l.currency,
+			"amount":    l.amount.String(),
+			"proposals": l.proposals,
+		},
+	)
+}
+
+type LockInfoBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Account   string   `bson:"account"`
+	Currency  string   `bson:"currency"`
+	Amount    string   `bson:"amount"`
+	Proposals []string `bson:"proposals"`
+}
+
+func (l *LockInfo) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of LockInfo")
+
+	var u LockInfoBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return l.unpack(enc, ht, u.Account, u.Currency, u.Amount, u.Proposals)
+}
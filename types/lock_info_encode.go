@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (l *LockInfo) unpack(enc encoder.Encoder, ht hint.Hint, ac, cr, am string, pids []string) error {
+	e := util.StringError("failed to unmarshal LockInfo")
+
+	l.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		l.account = a
+	}
+
+	l.currency = currencytypes.CurrencyID(cr)
+
+	big, err := common.NewBigFromString(am)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	l.amount = big
+
+	proposals := make([]string, len(pids))
+	copy(proposals, pids)
+	l.proposals = proposals
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type LockInfoJSONMarshaler struct {
+	hint.BaseHinter
+	Account   base.Address             `json:"account"`
+	Currency  currencytypes.CurrencyID `json:"currency"`
+	Amount    string                   `json:"amount"`
+	Proposals []string                 `json:"proposals"`
+}
+
+func (l LockInfo) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(LockInfoJSONMarshaler{
+		BaseHinter: l.BaseHinter,
+		Account:    l.account,
+		Currency:   l.currency,
+		Amount:     l.amount.String(),
+		Proposals:  l.proposals,
+	})
+}
+
+type LockInfoJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Account   string    `json:"account"`
+	Currency  string    `json:"currency"`
+	Amount    string    `json:"amount"`
+	Proposals []string  `json:"proposals"`
+}
+
+func (l *LockInfo) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of LockInfo")
+
+	var u LockInfoJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return l.unpack(enc, u.Hint, u.Account, u.Currency, u.Amount, u.Proposals)
+}
@@ -1,6 +1,10 @@
 package types
 
-import "github.com/ProtoconNet/mitum2/util"
+import (
+	"strconv"
+
+	"github.com/ProtoconNet/mitum2/util"
+)
 
 type Option uint8
 
@@ -18,9 +22,21 @@ const (
 	Completed
 	Rejected
 	Executed
+	Vetoed
 	NilStatus
 )
 
+// IsTerminal returns true when the voting of the proposal is over and
+// the voting power of the proposal is not needed any more.
+func (p ProposalStatus) IsTerminal() bool {
+	switch p {
+	case Canceled, Completed, Rejected, Executed, Vetoed:
+		return true
+	default:
+		return false
+	}
+}
+
 type Period Option
 
 func (p Period) Bytes() []byte {
@@ -36,5 +52,74 @@ const (
 	PostSnapshot
 	ExecutionDelay
 	Execute
+	Reveal // the reveal period of commit-reveal voting, between Voting and PostSnapshot
 	NilPeriod
 )
+
+type DepositStatus Option
+
+func (d DepositStatus) Bytes() []byte {
+	return util.Uint8ToBytes(uint8(d))
+}
+
+const (
+	DepositEscrowed DepositStatus = iota
+	DepositRefunded
+	DepositSlashed
+	NilDepositStatus
+)
+
+// VoteOptionFor, VoteOptionAgainst and VoteOptionAbstain are the vote options
+// of crypto proposals.
+const (
+	VoteOptionFor uint8 = iota
+	VoteOptionAgainst
+	VoteOptionAbstain
+)
+
+const (
+	VoteOptionNameFor     = "for"
+	VoteOptionNameAgainst = "against"
+	VoteOptionNameAbstain = "abstain"
+)
+
+// VoteOptionName returns the name of the vote option of the proposal. The last
+// option of every proposal is abstain and the other options of biz and hybrid
+// proposals are named by their numbers.
+func VoteOptionName(p Proposal, o uint8) string {
+	switch {
+	case o == p.VoteOptionsCount()-1:
+		return VoteOptionNameAbstain
+	case p.Option() == ProposalCrypto && o == VoteOptionFor:
+		return VoteOptionNameFor
+	case p.Option() == ProposalCrypto && o == VoteOptionAgainst:
+		return VoteOptionNameAgainst
+	default:
+		return strconv.FormatUint(uint64(o), 10)
+	}
+}
+
+// ParseVoteOption parses the name or the number of the vote option of the
+// proposal with the options vote options. abstain is the last option of every
+// proposal and the other names are of the crypto vote options.
+func ParseVoteOption(s string, options uint8) (uint8, error) {
+	if options < 1 {
+		return 0, util.ErrInvalid.Errorf("zero vote options")
+	}
+
+	switch s {
+	case VoteOptionNameFor:
+		return VoteOptionFor, nil
+	case VoteOptionNameAgainst:
+		return VoteOptionAgainst, nil
+	case VoteOptionNameAbstain:
+		return options - 1, nil
+	}
+
+	o, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, util.ErrInvalid.Errorf("invalid vote option; 'for' | 'against' | 'abstain' | number, %q", s)
+	}
+
+	return uint8(o), nil
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"math/big"
+	"sort"
+
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
@@ -77,6 +80,496 @@ func (wl Whitelist) IsExist(a base.Address) bool {
 	return false
 }
 
+var GuardianSetHint = hint.MustNewHint("mitum-dao-guardian-set-v0.0.1")
+
+// GuardianSet is the accounts which can veto completed proposals during the
+// execution delay. A proposal is vetoed when threshold guardians veto it.
+// Empty accounts means no guardian.
+type GuardianSet struct {
+	hint.BaseHinter
+	accounts  []base.Address
+	threshold uint
+}
+
+func NewGuardianSet(accounts []base.Address, threshold uint) GuardianSet {
+	return GuardianSet{
+		BaseHinter: hint.NewBaseHinter(GuardianSetHint),
+		accounts:   accounts,
+		threshold:  threshold,
+	}
+}
+
+func (gs GuardianSet) Bytes() []byte {
+	ads := make([][]byte, len(gs.accounts))
+	for i := range gs.accounts {
+		ads[i] = gs.accounts[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(ads...),
+		util.UintToBytes(gs.threshold),
+	)
+}
+
+func (gs GuardianSet) IsValid([]byte) error {
+	e := util.StringError("invalid guardian set")
+
+	if err := util.CheckIsValiders(nil, false, gs.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	founds := map[string]struct{}{}
+	for _, ac := range gs.accounts {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[ac.String()]; found {
+			return e.Wrap(util.ErrInvalid.Errorf("duplicate guardian, %s", ac))
+		}
+
+		founds[ac.String()] = struct{}{}
+	}
+
+	switch {
+	case len(gs.accounts) < 1 && gs.threshold != 0:
+		return e.Wrap(util.ErrInvalid.Errorf("threshold without guardians, %d", gs.threshold))
+	case len(gs.accounts) > 0 && (gs.threshold < 1 || gs.threshold > uint(len(gs.accounts))):
+		return e.Wrap(util.ErrInvalid.Errorf("threshold out of range, 1 <= %d <= %d", gs.threshold, len(gs.accounts)))
+	}
+
+	return nil
+}
+
+func (gs GuardianSet) Active() bool {
+	return len(gs.accounts) > 0
+}
+
+func (gs GuardianSet) Accounts() []base.Address {
+	return gs.accounts
+}
+
+func (gs GuardianSet) Threshold() uint {
+	return gs.threshold
+}
+
+func (gs GuardianSet) IsGuardian(a base.Address) bool {
+	for _, ac := range gs.accounts {
+		if ac.Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var TallyCommitteeHint = hint.MustNewHint("mitum-dao-tally-committee-v0.0.1")
+
+// TallyCommittee is the committee decrypting the tally of the encrypted
+// ballots. The secret key of the public key is shared over the members by
+// Shamir's secret sharing; the member at position i holds the key share of
+// index i+1 with the verification key g^share. The tally is decrypted by the
+// shares of threshold members. The decrypted tally of an option is bounded by
+// EncryptedTallyBound, the weights being counted in the unit of
+// VotingPowerBox.EncryptedWeightUnit, so the members solve its discrete
+// logarithm and claim the result in the unit. Empty members means the ballots
+// are not encrypted.
+type TallyCommittee struct {
+	hint.BaseHinter
+	members          []base.Address
+	verificationKeys []string
+	publicKey        string
+	threshold        uint
+}
+
+func NewTallyCommittee(members []base.Address, verificationKeys []string, publicKey string, threshold uint) TallyCommittee {
+	return TallyCommittee{
+		BaseHinter:       hint.NewBaseHinter(TallyCommitteeHint),
+		members:          members,
+		verificationKeys: verificationKeys,
+		publicKey:        publicKey,
+		threshold:        threshold,
+	}
+}
+
+func (tc TallyCommittee) Bytes() []byte {
+	bs := make([][]byte, len(tc.members))
+	for i := range tc.members {
+		bs[i] = util.ConcatBytesSlice(tc.members[i].Bytes(), []byte(tc.verificationKeys[i]))
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(bs...),
+		[]byte(tc.publicKey),
+		util.UintToBytes(tc.threshold),
+	)
+}
+
+func (tc TallyCommittee) IsValid([]byte) error {
+	e := util.StringError("invalid tally committee")
+
+	if err := util.CheckIsValiders(nil, false, tc.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(tc.members) < 1 {
+		if len(tc.verificationKeys) > 0 || len(tc.publicKey) > 0 || tc.threshold != 0 {
+			return e.Wrap(util.ErrInvalid.Errorf("keys or threshold without members"))
+		}
+
+		return nil
+	}
+
+	if len(tc.verificationKeys) != len(tc.members) {
+		return e.Wrap(util.ErrInvalid.Errorf("verification keys not matched with members, %d != %d", len(tc.verificationKeys), len(tc.members)))
+	}
+
+	if tc.threshold < 1 || tc.threshold > uint(len(tc.members)) {
+		return e.Wrap(util.ErrInvalid.Errorf("threshold out of range, 1 <= %d <= %d", tc.threshold, len(tc.members)))
+	}
+
+	if _, err := parseGroupElement(tc.publicKey); err != nil {
+		return e.Wrap(util.ErrInvalid.Errorf("invalid public key, %v", err))
+	}
+
+	founds := map[string]struct{}{}
+	for i, ac := range tc.members {
+		if err := ac.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[ac.String()]; found {
+			return e.Wrap(util.ErrInvalid.Errorf("duplicate tally committee member, %s", ac))
+		}
+
+		founds[ac.String()] = struct{}{}
+
+		if _, err := parseGroupElement(tc.verificationKeys[i]); err != nil {
+			return e.Wrap(util.ErrInvalid.Errorf("invalid verification key of %s, %v", ac, err))
+		}
+	}
+
+	return nil
+}
+
+func (tc TallyCommittee) Active() bool {
+	return len(tc.members) > 0
+}
+
+func (tc TallyCommittee) Members() []base.Address {
+	return tc.members
+}
+
+func (tc TallyCommittee) VerificationKeys() []string {
+	return tc.verificationKeys
+}
+
+func (tc TallyCommittee) PublicKey() string {
+	return tc.publicKey
+}
+
+func (tc TallyCommittee) Threshold() uint {
+	return tc.threshold
+}
+
+// Index returns the key share index of the member.
+func (tc TallyCommittee) Index(a base.Address) (uint64, bool) {
+	for i, ac := range tc.members {
+		if ac.Equal(a) {
+			return uint64(i + 1), true
+		}
+	}
+
+	return 0, false
+}
+
+// VerificationKey returns the verification key of the key share of the member.
+func (tc TallyCommittee) VerificationKey(a base.Address) (string, bool) {
+	index, found := tc.Index(a)
+	if !found {
+		return "", false
+	}
+
+	return tc.verificationKeys[index-1], true
+}
+
+var FeeSponsorshipHint = hint.MustNewHint("mitum-dao-fee-sponsorship-v0.0.1")
+
+// FeeSponsorship lets the balance of the DAO contract account pay the
+// currency fee of the governance operations, Register, Vote, PreSnap, PostSnap
+// and Execute, of the proposals. The fee in the currency is sponsored until
+// the fees sponsored to an account for a proposal reach the account cap and
+// the fees sponsored by the DAO reach the budget; beyond them the sender pays.
+// Zero budget means the fees are not sponsored.
+type FeeSponsorship struct {
+	hint.BaseHinter
+	currency   currencytypes.CurrencyID
+	accountCap common.Big
+	budget     common.Big
+}
+
+func NewFeeSponsorship(currency currencytypes.CurrencyID, accountCap, budget common.Big) FeeSponsorship {
+	return FeeSponsorship{
+		BaseHinter: hint.NewBaseHinter(FeeSponsorshipHint),
+		currency:   currency,
+		accountCap: accountCap,
+		budget:     budget,
+	}
+}
+
+func (fs FeeSponsorship) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fs.currency.Bytes(),
+		fs.accountCap.Bytes(),
+		fs.budget.Bytes(),
+	)
+}
+
+func (fs FeeSponsorship) IsValid([]byte) error {
+	e := util.StringError("invalid fee sponsorship")
+
+	if err := util.CheckIsValiders(nil, false, fs.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !fs.Active() {
+		if len(fs.currency) > 0 || fs.accountCap.OverZero() {
+			return e.Wrap(util.ErrInvalid.Errorf("currency or account cap without budget"))
+		}
+
+		return nil
+	}
+
+	if err := fs.currency.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if !fs.accountCap.OverZero() {
+		return e.Wrap(util.ErrInvalid.Errorf("account cap must be over zero"))
+	}
+
+	if fs.accountCap.Compare(fs.budget) > 0 {
+		return e.Wrap(util.ErrInvalid.Errorf("account cap over budget, %s > %s", fs.accountCap, fs.budget))
+	}
+
+	return nil
+}
+
+func (fs FeeSponsorship) Active() bool {
+	return fs.budget.OverZero()
+}
+
+func (fs FeeSponsorship) Currency() currencytypes.CurrencyID {
+	return fs.currency
+}
+
+func (fs FeeSponsorship) AccountCap() common.Big {
+	return fs.accountCap
+}
+
+func (fs FeeSponsorship) Budget() common.Big {
+	return fs.budget
+}
+
+var ApprovalThresholdsHint = hint.MustNewHint("mitum-dao-approval-thresholds-v0.0.1")
+
+// ApprovalThresholds is the approval ratios of crypto proposals keyed by
+// calldata type. A crypto proposal passes only when the approving votes reach
+// the ratio of the votes of the approval base; a proposal of several call data
+// follows the strictest ratio among them. Call data types without ratio follow
+// the quorum only.
+type ApprovalThresholds struct {
+	hint.BaseHinter
+	thresholds map[string]PercentRatio
+}
+
+func NewApprovalThresholds(thresholds map[string]PercentRatio) ApprovalThresholds {
+	return ApprovalThresholds{
+		BaseHinter: hint.NewBaseHinter(ApprovalThresholdsHint),
+		thresholds: thresholds,
+	}
+}
+
+func (at ApprovalThresholds) Bytes() []byte {
+	keys := at.keys()
+
+	bs := make([][]byte, len(keys))
+	for i, k := range keys {
+		bs[i] = util.ConcatBytesSlice([]byte(k), at.thresholds[k].Bytes())
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (at ApprovalThresholds) IsValid([]byte) error {
+	e := util.StringError("invalid approval thresholds")
+
+	if err := util.CheckIsValiders(nil, false, at.BaseHinter); err != nil {
+		return e.Wrap(err)
+	}
+
+	for k, r := range at.thresholds {
+		switch k {
+		case CalldataTransfer, CalldataGovernance, CalldataOperation:
+		default:
+			return e.Wrap(util.ErrInvalid.Errorf("unknown calldata type, %q", k))
+		}
+
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (at ApprovalThresholds) Thresholds() map[string]PercentRatio {
+	return at.thresholds
+}
+
+// Threshold returns the approval ratio of the calldata type.
+func (at ApprovalThresholds) Threshold(calldataType string) (PercentRatio, bool) {
+	r, found := at.thresholds[calldataType]
+
+	return r, found
+}
+
+// Strictest returns the highest approval ratio among the call data with its
+// calldata type as the rule. It returns false when no call data has ratio.
+func (at ApprovalThresholds) Strictest(callDatas []CallData) (string, PercentRatio, bool) {
+	var rule string
+	var ratio PercentRatio
+	var found bool
+
+	for _, c := range callDatas {
+		r, ok := at.thresholds[c.Type()]
+		if !ok || (found && r <= ratio) {
+			continue
+		}
+
+		rule, ratio, found = c.Type(), r, true
+	}
+
+	return rule, ratio, found
+}
+
+func (at ApprovalThresholds) keys() []string {
+	keys := make([]string, 0, len(at.thresholds))
+	for k := range at.thresholds {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// VotingWeightQuadratic counts the votes in the square roots of the voting
+// powers. The turnout is measured in the voting powers in both modes.
+const (
+	VotingWeightLinear    = VotingWeightMode("linear")
+	VotingWeightQuadratic = VotingWeightMode("quadratic")
+)
+
+type VotingWeightMode string
+
+func (m VotingWeightMode) IsValid([]byte) error {
+	switch m {
+	case VotingWeightLinear, VotingWeightQuadratic:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid voting weight mode; 'linear' | 'quadratic'")
+	}
+}
+
+func (m VotingWeightMode) Bytes() []byte {
+	return []byte(m)
+}
+
+// Weight returns the effective weight counted for the voting power amount.
+func (m VotingWeightMode) Weight(amount common.Big) common.Big {
+	if m != VotingWeightQuadratic || !amount.OverZero() {
+		return amount
+	}
+
+	return common.NewBigFromBigInt(new(big.Int).Sqrt(amount.Int))
+}
+
+const (
+	PeriodUnitSecond = PeriodUnit("second")
+	PeriodUnitHeight = PeriodUnit("height")
+)
+
+// PeriodUnit is the unit of the lifecycle periods of the policy and the start
+// of the proposals; seconds of the block time or block heights.
+type PeriodUnit string
+
+func (u PeriodUnit) IsValid([]byte) error {
+	switch u {
+	case PeriodUnitSecond, PeriodUnitHeight:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid period unit; 'second' | 'height'")
+	}
+}
+
+func (u PeriodUnit) Bytes() []byte {
+	return []byte(u)
+}
+
+// Now returns the current point of the block in the period unit.
+func (u PeriodUnit) Now(blockmap base.BlockMap) uint64 {
+	if u == PeriodUnitHeight {
+		return uint64(blockmap.Manifest().Height())
+	}
+
+	return uint64(blockmap.Manifest().ProposedAt().Unix())
+}
+
+const (
+	AbstainCountQuorum = AbstainMode("quorum")
+	AbstainIgnore      = AbstainMode("ignore")
+)
+
+// AbstainMode decides whether the abstaining votes count toward the quorum.
+// Abstaining votes never approve a proposal.
+type AbstainMode string
+
+func (m AbstainMode) IsValid([]byte) error {
+	switch m {
+	case AbstainCountQuorum, AbstainIgnore:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid abstain mode; 'quorum' | 'ignore'")
+	}
+}
+
+func (m AbstainMode) Bytes() []byte {
+	return []byte(m)
+}
+
+const (
+	ApprovalBaseForAgainst = ApprovalBase("for-against")
+	ApprovalBaseCast       = ApprovalBase("cast")
+)
+
+// ApprovalBase is the votes the approving votes of crypto proposals are
+// measured against; the approving and rejecting votes or all votes cast
+// including the abstaining votes.
+type ApprovalBase string
+
+func (b ApprovalBase) IsValid([]byte) error {
+	switch b {
+	case ApprovalBaseForAgainst, ApprovalBaseCast:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid approval base; 'for-against' | 'cast'")
+	}
+}
+
+func (b ApprovalBase) Bytes() []byte {
+	return []byte(b)
+}
+
 var PolicyHint = hint.MustNewHint("mitum-dao-policy-v0.0.1")
 
 type Policy struct {
@@ -93,6 +586,20 @@ type Policy struct {
 	executionDelayPeriod uint64
 	turnout              PercentRatio
 	quorum               PercentRatio
+	allowVoteChange      bool
+	votingWeightMode     VotingWeightMode
+	escrowDeposit        bool
+	guardians            GuardianSet
+	periodUnit           PeriodUnit
+	approvalThresholds   ApprovalThresholds
+	abstainMode          AbstainMode
+	approvalBase         ApprovalBase
+	antiSnipingWindow    uint64
+	antiSnipingExtension uint64
+	revealPeriod         uint64
+	tallyCommittee       TallyCommittee
+	relayFeeReimbursed   bool
+	feeSponsorship       FeeSponsorship
 }
 
 func NewPolicy(
@@ -102,6 +609,20 @@ func NewPolicy(
 	whitelist Whitelist,
 	proposalReviewPeriod, registrationPeriod, preSnapshotPeriod, votingPeriod, postSnapshotPeriod, executionDelayPeriod uint64,
 	turnout, quorum PercentRatio,
+	allowVoteChange bool,
+	votingWeightMode VotingWeightMode,
+	escrowDeposit bool,
+	guardians GuardianSet,
+	periodUnit PeriodUnit,
+	approvalThresholds ApprovalThresholds,
+	abstainMode AbstainMode,
+	approvalBase ApprovalBase,
+	antiSnipingWindow uint64,
+	antiSnipingExtension uint64,
+	revealPeriod uint64,
+	tallyCommittee TallyCommittee,
+	relayFeeReimbursed bool,
+	feeSponsorship FeeSponsorship,
 ) Policy {
 	return Policy{
 		BaseHinter:           hint.NewBaseHinter(PolicyHint),
@@ -117,6 +638,20 @@ func NewPolicy(
 		executionDelayPeriod: executionDelayPeriod,
 		turnout:              turnout,
 		quorum:               quorum,
+		allowVoteChange:      allowVoteChange,
+		votingWeightMode:     votingWeightMode,
+		escrowDeposit:        escrowDeposit,
+		guardians:            guardians,
+		periodUnit:           periodUnit,
+		approvalThresholds:   approvalThresholds,
+		abstainMode:          abstainMode,
+		approvalBase:         approvalBase,
+		antiSnipingWindow:    antiSnipingWindow,
+		antiSnipingExtension: antiSnipingExtension,
+		revealPeriod:         revealPeriod,
+		tallyCommittee:       tallyCommittee,
+		relayFeeReimbursed:   relayFeeReimbursed,
+		feeSponsorship:       feeSponsorship,
 	}
 }
 
@@ -134,9 +669,68 @@ func (po Policy) Bytes() []byte {
 		util.Uint64ToBytes(po.executionDelayPeriod),
 		po.turnout.Bytes(),
 		po.quorum.Bytes(),
+		po.ExtensionBytes(),
 	)
 }
 
+// ExtensionBytes returns the bytes of the fields added after the first layout
+// of the policy. It is empty when the fields are default, so the policies and
+// the facts without them keep the bytes and the hashes of the first layout.
+func (po Policy) ExtensionBytes() []byte {
+	if po.isDefaultExtension() {
+		return nil
+	}
+
+	var avc int8
+	if po.allowVoteChange {
+		avc = 1
+	}
+
+	var ed int8
+	if po.escrowDeposit {
+		ed = 1
+	}
+
+	var rfr int8
+	if po.relayFeeReimbursed {
+		rfr = 1
+	}
+
+	return util.ConcatBytesSlice(
+		[]byte{byte(avc)},
+		po.votingWeightMode.Bytes(),
+		[]byte{byte(ed)},
+		po.guardians.Bytes(),
+		po.periodUnit.Bytes(),
+		po.approvalThresholds.Bytes(),
+		po.abstainMode.Bytes(),
+		po.approvalBase.Bytes(),
+		util.Uint64ToBytes(po.antiSnipingWindow),
+		util.Uint64ToBytes(po.antiSnipingExtension),
+		util.Uint64ToBytes(po.revealPeriod),
+		po.tallyCommittee.Bytes(),
+		[]byte{byte(rfr)},
+		po.feeSponsorship.Bytes(),
+	)
+}
+
+func (po Policy) isDefaultExtension() bool {
+	return !po.allowVoteChange &&
+		(len(po.votingWeightMode) < 1 || po.votingWeightMode == VotingWeightLinear) &&
+		!po.escrowDeposit &&
+		!po.guardians.Active() &&
+		(len(po.periodUnit) < 1 || po.periodUnit == PeriodUnitSecond) &&
+		len(po.approvalThresholds.Thresholds()) < 1 &&
+		(len(po.abstainMode) < 1 || po.abstainMode == AbstainCountQuorum) &&
+		(len(po.approvalBase) < 1 || po.approvalBase == ApprovalBaseForAgainst) &&
+		po.antiSnipingWindow == 0 &&
+		po.antiSnipingExtension == 0 &&
+		po.revealPeriod == 0 &&
+		!po.tallyCommittee.Active() &&
+		!po.relayFeeReimbursed &&
+		!po.feeSponsorship.Active()
+}
+
 func (po Policy) IsValid([]byte) error {
 	e := util.StringError("invalid dao policy")
 
@@ -148,10 +742,22 @@ func (po Policy) IsValid([]byte) error {
 		po.whitelist,
 		po.turnout,
 		po.quorum,
+		po.votingWeightMode,
+		po.guardians,
+		po.periodUnit,
+		po.approvalThresholds,
+		po.tallyCommittee,
+		po.feeSponsorship,
+		po.abstainMode,
+		po.approvalBase,
 	); err != nil {
 		return e.Wrap(err)
 	}
 
+	if po.CommitReveal() && po.EncryptedVoting() {
+		return e.Wrap(util.ErrInvalid.Errorf("commit-reveal voting with encrypted ballots"))
+	}
+
 	return nil
 }
 
@@ -202,3 +808,80 @@ func (po Policy) Turnout() PercentRatio {
 func (po Policy) Quorum() PercentRatio {
 	return po.quorum
 }
+
+func (po Policy) AllowVoteChange() bool {
+	return po.allowVoteChange
+}
+
+func (po Policy) VotingWeightMode() VotingWeightMode {
+	return po.votingWeightMode
+}
+
+// EscrowDeposit returns true when the threshold amount is escrowed as the
+// proposal deposit instead of only being checked in the proposer's balance.
+func (po Policy) EscrowDeposit() bool {
+	return po.escrowDeposit
+}
+
+func (po Policy) Guardians() GuardianSet {
+	return po.guardians
+}
+
+func (po Policy) PeriodUnit() PeriodUnit {
+	return po.periodUnit
+}
+
+func (po Policy) ApprovalThresholds() ApprovalThresholds {
+	return po.approvalThresholds
+}
+
+func (po Policy) AbstainMode() AbstainMode {
+	return po.abstainMode
+}
+
+func (po Policy) ApprovalBase() ApprovalBase {
+	return po.approvalBase
+}
+
+// AntiSnipingWindow is the last part of the voting period where a vote
+// flipping the leading option extends the voting period of the proposal by
+// AntiSnipingExtension. Zero window or extension disables the extension.
+func (po Policy) AntiSnipingWindow() uint64 {
+	return po.antiSnipingWindow
+}
+
+func (po Policy) AntiSnipingExtension() uint64 {
+	return po.antiSnipingExtension
+}
+
+// RevealPeriod is the period after the voting period where the committed
+// votes are revealed. Zero disables commit-reveal voting.
+func (po Policy) RevealPeriod() uint64 {
+	return po.revealPeriod
+}
+
+// CommitReveal returns true when the votes are committed in the voting period
+// and revealed in the reveal period.
+func (po Policy) CommitReveal() bool {
+	return po.revealPeriod > 0
+}
+
+func (po Policy) TallyCommittee() TallyCommittee {
+	return po.tallyCommittee
+}
+
+// RelayFeeReimbursed returns true when the fee of a relayed vote batch is
+// paid from the balance of the DAO contract account instead of the relayer.
+func (po Policy) RelayFeeReimbursed() bool {
+	return po.relayFeeReimbursed
+}
+
+func (po Policy) FeeSponsorship() FeeSponsorship {
+	return po.feeSponsorship
+}
+
+// EncryptedVoting returns true when the votes are encrypted to the tally
+// committee and only the sums of the votes are decrypted.
+func (po Policy) EncryptedVoting() bool {
+	return po.tallyCommittee.Active()
+}
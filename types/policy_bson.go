@@ -40,6 +40,138 @@ func (wl *Whitelist) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	return wl.unpack(enc, ht, uw.Active, uw.Accounts)
 }
 
+func (gs GuardianSet) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     gs.Hint().String(),
+			"accounts":  gs.accounts,
+			"threshold": gs.threshold,
+		},
+	)
+}
+
+type GuardianSetBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Accounts  []string `bson:"accounts"`
+	Threshold uint     `bson:"threshold"`
+}
+
+func (gs *GuardianSet) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GuardianSet")
+
+	var ug GuardianSetBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ug); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ug.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return gs.unpack(enc, ht, ug.Accounts, ug.Threshold)
+}
+
+func (tc TallyCommittee) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":             tc.Hint().String(),
+			"members":           tc.members,
+			"verification_keys": tc.verificationKeys,
+			"public_key":        tc.publicKey,
+			"threshold":         tc.threshold,
+		},
+	)
+}
+
+type TallyCommitteeBSONUnmarshaler struct {
+	Hint             string   `bson:"_hint"`
+	Members          []string `bson:"members"`
+	VerificationKeys []string `bson:"verification_keys"`
+	PublicKey        string   `bson:"public_key"`
+	Threshold        uint     `bson:"threshold"`
+}
+
+func (tc *TallyCommittee) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TallyCommittee")
+
+	var ut TallyCommitteeBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ut); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ut.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return tc.unpack(enc, ht, ut.Members, ut.VerificationKeys, ut.PublicKey, ut.Threshold)
+}
+
+func (fs FeeSponsorship) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       fs.Hint().String(),
+			"currency":    fs.currency,
+			"account_cap": fs.accountCap.String(),
+			"budget":      fs.budget.String(),
+		},
+	)
+}
+
+type FeeSponsorshipBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Currency   string `bson:"currency"`
+	AccountCap string `bson:"account_cap"`
+	Budget     string `bson:"budget"`
+}
+
+func (fs *FeeSponsorship) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of FeeSponsorship")
+
+	var uf FeeSponsorshipBSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return fs.unpack(ht, uf.Currency, uf.AccountCap, uf.Budget)
+}
+
+func (at ApprovalThresholds) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      at.Hint().String(),
+			"thresholds": at.thresholds,
+		},
+	)
+}
+
+type ApprovalThresholdsBSONUnmarshaler struct {
+	Hint       string          `bson:"_hint"`
+	Thresholds map[string]uint `bson:"thresholds"`
+}
+
+func (at *ApprovalThresholds) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ApprovalThresholds")
+
+	var ua ApprovalThresholdsBSONUnmarshaler
+	if err := enc.Unmarshal(b, &ua); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(ua.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return at.unpack(ht, ua.Thresholds)
+}
+
 func (po Policy) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
@@ -56,6 +188,20 @@ func (po Policy) MarshalBSON() ([]byte, error) {
 			"execution_delay_period": po.executionDelayPeriod,
 			"turnout":                po.turnout,
 			"quorum":                 po.quorum,
+			"allow_vote_change":      po.allowVoteChange,
+			"voting_weight_mode":     po.votingWeightMode,
+			"escrow_deposit":         po.escrowDeposit,
+			"guardians":              po.guardians,
+			"period_unit":            po.periodUnit,
+			"approval_thresholds":    po.approvalThresholds,
+			"abstain_mode":           po.abstainMode,
+			"approval_base":          po.approvalBase,
+			"anti_sniping_window":    po.antiSnipingWindow,
+			"anti_sniping_extension": po.antiSnipingExtension,
+			"reveal_period":          po.revealPeriod,
+			"tally_committee":        po.tallyCommittee,
+			"relay_fee_reimbursed":   po.relayFeeReimbursed,
+			"fee_sponsorship":        po.feeSponsorship,
 		},
 	)
 }
@@ -74,6 +220,20 @@ type PolicyBSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64   `bson:"execution_delay_period"`
 	Turnout              uint     `bson:"turnout"`
 	Quorum               uint     `bson:"quorum"`
+	AllowVoteChange      bool     `bson:"allow_vote_change"`
+	VotingWeightMode     string   `bson:"voting_weight_mode"`
+	EscrowDeposit        bool     `bson:"escrow_deposit"`
+	Guardians            bson.Raw `bson:"guardians"`
+	PeriodUnit           string   `bson:"period_unit"`
+	ApprovalThresholds   bson.Raw `bson:"approval_thresholds"`
+	AbstainMode          string   `bson:"abstain_mode"`
+	ApprovalBase         string   `bson:"approval_base"`
+	AntiSnipingWindow    uint64   `bson:"anti_sniping_window"`
+	AntiSnipingExtension uint64   `bson:"anti_sniping_extension"`
+	RevealPeriod         uint64   `bson:"reveal_period"`
+	TallyCommittee       bson.Raw `bson:"tally_committee"`
+	RelayFeeReimbursed   bool     `bson:"relay_fee_reimbursed"`
+	FeeSponsorship       bson.Raw `bson:"fee_sponsorship"`
 }
 
 func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -102,5 +262,19 @@ func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		upo.ExecutionDelayPeriod,
 		upo.Turnout,
 		upo.Quorum,
+		upo.AllowVoteChange,
+		upo.VotingWeightMode,
+		upo.EscrowDeposit,
+		upo.Guardians,
+		upo.PeriodUnit,
+		upo.ApprovalThresholds,
+		upo.AbstainMode,
+		upo.ApprovalBase,
+		upo.AntiSnipingWindow,
+		upo.AntiSnipingExtension,
+		upo.RevealPeriod,
+		upo.TallyCommittee,
+		upo.RelayFeeReimbursed,
+		upo.FeeSponsorship,
 	)
 }
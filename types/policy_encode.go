@@ -31,11 +31,100 @@ func (wl *Whitelist) unpack(enc encoder.Encoder, ht hint.Hint, at bool, acs []st
 	return nil
 }
 
+func (gs *GuardianSet) unpack(enc encoder.Encoder, ht hint.Hint, acs []string, th uint) error {
+	e := util.StringError("failed to unmarshal GuardianSet")
+
+	gs.BaseHinter = hint.NewBaseHinter(ht)
+	gs.threshold = th
+
+	accs := make([]base.Address, len(acs))
+	for i, ac := range acs {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			accs[i] = a
+		}
+	}
+	gs.accounts = accs
+
+	return nil
+}
+
+func (tc *TallyCommittee) unpack(enc encoder.Encoder, ht hint.Hint, mbs []string, vks []string, pk string, th uint) error {
+	e := util.StringError("failed to unmarshal TallyCommittee")
+
+	tc.BaseHinter = hint.NewBaseHinter(ht)
+	tc.verificationKeys = vks
+	tc.publicKey = pk
+	tc.threshold = th
+
+	members := make([]base.Address, len(mbs))
+	for i, ac := range mbs {
+		switch a, err := base.DecodeAddress(ac, enc); {
+		case err != nil:
+			return e.Wrap(err)
+		default:
+			members[i] = a
+		}
+	}
+	tc.members = members
+
+	return nil
+}
+
+func (fs *FeeSponsorship) unpack(ht hint.Hint, cr, ac, bg string) error {
+	e := util.StringError("failed to unmarshal FeeSponsorship")
+
+	fs.BaseHinter = hint.NewBaseHinter(ht)
+	fs.currency = currencytypes.CurrencyID(cr)
+
+	accountCap, err := common.NewBigFromString(ac)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fs.accountCap = accountCap
+
+	budget, err := common.NewBigFromString(bg)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fs.budget = budget
+
+	return nil
+}
+
+func (at *ApprovalThresholds) unpack(ht hint.Hint, ths map[string]uint) error {
+	at.BaseHinter = hint.NewBaseHinter(ht)
+
+	thresholds := make(map[string]PercentRatio, len(ths))
+	for k, r := range ths {
+		thresholds[k] = PercentRatio(r)
+	}
+	at.thresholds = thresholds
+
+	return nil
+}
+
 func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint,
 	cr, th string,
 	bf, bw []byte,
 	rvp, rgp, prsp, vp, psp, edp uint64,
 	to, qou uint,
+	avc bool,
+	vwm string,
+	ed bool,
+	bg []byte,
+	pu string,
+	bat []byte,
+	am string,
+	ab string,
+	asw uint64,
+	ase uint64,
+	rvlp uint64,
+	btc []byte,
+	rfr bool,
+	bfs []byte,
 ) error {
 	e := util.StringError("failed to unmarshal Policy")
 
@@ -49,6 +138,28 @@ func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint,
 	po.executionDelayPeriod = edp
 	po.turnout = PercentRatio(to)
 	po.quorum = PercentRatio(qou)
+	po.allowVoteChange = avc
+	po.votingWeightMode = VotingWeightMode(vwm)
+	if len(vwm) < 1 { // policies without voting weight mode weigh voting power linearly
+		po.votingWeightMode = VotingWeightLinear
+	}
+	po.escrowDeposit = ed
+	po.periodUnit = PeriodUnit(pu)
+	if len(pu) < 1 { // policies without period unit count periods in seconds
+		po.periodUnit = PeriodUnitSecond
+	}
+	po.abstainMode = AbstainMode(am)
+	if len(am) < 1 { // policies without abstain mode count abstaining votes toward the quorum
+		po.abstainMode = AbstainCountQuorum
+	}
+	po.approvalBase = ApprovalBase(ab)
+	if len(ab) < 1 { // policies without approval base measure approval against for and against
+		po.approvalBase = ApprovalBaseForAgainst
+	}
+	po.antiSnipingWindow = asw
+	po.antiSnipingExtension = ase
+	po.revealPeriod = rvlp
+	po.relayFeeReimbursed = rfr
 
 	if big, err := common.NewBigFromString(th); err != nil {
 		return e.Wrap(err)
@@ -72,5 +183,61 @@ func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint,
 		po.whitelist = wl
 	}
 
+	// policies without guardian set are decoded with empty guardian set
+	switch hinter, err := enc.Decode(bg); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		po.guardians = NewGuardianSet(nil, 0)
+	default:
+		gs, ok := hinter.(GuardianSet)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected GuardianSet, not %T", hinter))
+		}
+		po.guardians = gs
+	}
+
+	// policies without approval thresholds are decoded with empty thresholds
+	switch hinter, err := enc.Decode(bat); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		po.approvalThresholds = NewApprovalThresholds(nil)
+	default:
+		at, ok := hinter.(ApprovalThresholds)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected ApprovalThresholds, not %T", hinter))
+		}
+		po.approvalThresholds = at
+	}
+
+	// policies without tally committee are decoded with empty committee
+	switch hinter, err := enc.Decode(btc); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		po.tallyCommittee = NewTallyCommittee(nil, nil, "", 0)
+	default:
+		tc, ok := hinter.(TallyCommittee)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected TallyCommittee, not %T", hinter))
+		}
+		po.tallyCommittee = tc
+	}
+
+	// policies without fee sponsorship are decoded with inactive sponsorship
+	switch hinter, err := enc.Decode(bfs); {
+	case err != nil:
+		return e.Wrap(err)
+	case hinter == nil:
+		po.feeSponsorship = NewFeeSponsorship("", common.ZeroBig, common.ZeroBig)
+	default:
+		fs, ok := hinter.(FeeSponsorship)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected FeeSponsorship, not %T", hinter))
+		}
+		po.feeSponsorship = fs
+	}
+
 	return nil
 }
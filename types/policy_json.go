@@ -42,6 +42,136 @@ func (wl *Whitelist) DecodeJSON(b []byte, enc encoder.Encoder) error {
 	return wl.unpack(enc, uw.Hint, uw.Active, uw.Accounts)
 }
 
+type GuardianSetJSONMarshaler struct {
+	hint.BaseHinter
+	Accounts  []base.Address `json:"accounts"`
+	Threshold uint           `json:"threshold"`
+}
+
+func (gs GuardianSet) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GuardianSetJSONMarshaler{
+		BaseHinter: gs.BaseHinter,
+		Accounts:   gs.accounts,
+		Threshold:  gs.threshold,
+	})
+}
+
+type GuardianSetJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Accounts  []string  `json:"accounts"`
+	Threshold uint      `json:"threshold"`
+}
+
+func (gs *GuardianSet) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of GuardianSet")
+
+	var ug GuardianSetJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ug); err != nil {
+		return e.Wrap(err)
+	}
+
+	return gs.unpack(enc, ug.Hint, ug.Accounts, ug.Threshold)
+}
+
+type TallyCommitteeJSONMarshaler struct {
+	hint.BaseHinter
+	Members          []base.Address `json:"members"`
+	VerificationKeys []string       `json:"verification_keys"`
+	PublicKey        string         `json:"public_key"`
+	Threshold        uint           `json:"threshold"`
+}
+
+func (tc TallyCommittee) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TallyCommitteeJSONMarshaler{
+		BaseHinter:       tc.BaseHinter,
+		Members:          tc.members,
+		VerificationKeys: tc.verificationKeys,
+		PublicKey:        tc.publicKey,
+		Threshold:        tc.threshold,
+	})
+}
+
+type TallyCommitteeJSONUnmarshaler struct {
+	Hint             hint.Hint `json:"_hint"`
+	Members          []string  `json:"members"`
+	VerificationKeys []string  `json:"verification_keys"`
+	PublicKey        string    `json:"public_key"`
+	Threshold        uint      `json:"threshold"`
+}
+
+func (tc *TallyCommittee) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of TallyCommittee")
+
+	var ut TallyCommitteeJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ut); err != nil {
+		return e.Wrap(err)
+	}
+
+	return tc.unpack(enc, ut.Hint, ut.Members, ut.VerificationKeys, ut.PublicKey, ut.Threshold)
+}
+
+type FeeSponsorshipJSONMarshaler struct {
+	hint.BaseHinter
+	Currency   currencytypes.CurrencyID `json:"currency"`
+	AccountCap string                   `json:"account_cap"`
+	Budget     string                   `json:"budget"`
+}
+
+func (fs FeeSponsorship) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(FeeSponsorshipJSONMarshaler{
+		BaseHinter: fs.BaseHinter,
+		Currency:   fs.currency,
+		AccountCap: fs.accountCap.String(),
+		Budget:     fs.budget.String(),
+	})
+}
+
+type FeeSponsorshipJSONUnmarshaler struct {
+	Hint       hint.Hint `json:"_hint"`
+	Currency   string    `json:"currency"`
+	AccountCap string    `json:"account_cap"`
+	Budget     string    `json:"budget"`
+}
+
+func (fs *FeeSponsorship) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of FeeSponsorship")
+
+	var uf FeeSponsorshipJSONUnmarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	return fs.unpack(uf.Hint, uf.Currency, uf.AccountCap, uf.Budget)
+}
+
+type ApprovalThresholdsJSONMarshaler struct {
+	hint.BaseHinter
+	Thresholds map[string]PercentRatio `json:"thresholds"`
+}
+
+func (at ApprovalThresholds) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApprovalThresholdsJSONMarshaler{
+		BaseHinter: at.BaseHinter,
+		Thresholds: at.thresholds,
+	})
+}
+
+type ApprovalThresholdsJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Thresholds map[string]uint `json:"thresholds"`
+}
+
+func (at *ApprovalThresholds) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ApprovalThresholds")
+
+	var ua ApprovalThresholdsJSONUnmarshaler
+	if err := enc.Unmarshal(b, &ua); err != nil {
+		return e.Wrap(err)
+	}
+
+	return at.unpack(ua.Hint, ua.Thresholds)
+}
+
 type PolicyJSONMarshaler struct {
 	hint.BaseHinter
 	Token                currencytypes.CurrencyID `json:"token"`
@@ -56,6 +186,20 @@ type PolicyJSONMarshaler struct {
 	ExecutionDelayPeriod uint64                   `json:"execution_delay_period"`
 	Turnout              PercentRatio             `json:"turnout"`
 	Quorum               PercentRatio             `json:"quorum"`
+	AllowVoteChange      bool                     `json:"allow_vote_change"`
+	VotingWeightMode     VotingWeightMode         `json:"voting_weight_mode"`
+	EscrowDeposit        bool                     `json:"escrow_deposit"`
+	Guardians            GuardianSet              `json:"guardians"`
+	PeriodUnit           PeriodUnit               `json:"period_unit"`
+	ApprovalThresholds   ApprovalThresholds       `json:"approval_thresholds"`
+	AbstainMode          AbstainMode              `json:"abstain_mode"`
+	ApprovalBase         ApprovalBase             `json:"approval_base"`
+	AntiSnipingWindow    uint64                   `json:"anti_sniping_window"`
+	AntiSnipingExtension uint64                   `json:"anti_sniping_extension"`
+	RevealPeriod         uint64                   `json:"reveal_period"`
+	TallyCommittee       TallyCommittee           `json:"tally_committee"`
+	RelayFeeReimbursed   bool                     `json:"relay_fee_reimbursed"`
+	FeeSponsorship       FeeSponsorship           `json:"fee_sponsorship"`
 }
 
 func (po Policy) MarshalJSON() ([]byte, error) {
@@ -73,6 +217,20 @@ func (po Policy) MarshalJSON() ([]byte, error) {
 		ExecutionDelayPeriod: po.executionDelayPeriod,
 		Turnout:              po.turnout,
 		Quorum:               po.quorum,
+		AllowVoteChange:      po.allowVoteChange,
+		VotingWeightMode:     po.votingWeightMode,
+		EscrowDeposit:        po.escrowDeposit,
+		Guardians:            po.guardians,
+		PeriodUnit:           po.periodUnit,
+		ApprovalThresholds:   po.approvalThresholds,
+		AbstainMode:          po.abstainMode,
+		ApprovalBase:         po.approvalBase,
+		AntiSnipingWindow:    po.antiSnipingWindow,
+		AntiSnipingExtension: po.antiSnipingExtension,
+		RevealPeriod:         po.revealPeriod,
+		TallyCommittee:       po.tallyCommittee,
+		RelayFeeReimbursed:   po.relayFeeReimbursed,
+		FeeSponsorship:       po.feeSponsorship,
 	})
 }
 
@@ -90,6 +248,20 @@ type PolicyJSONUnmarshaler struct {
 	ExecutionDelayPeriod uint64          `json:"execution_delay_period"`
 	Turnout              uint            `json:"turnout"`
 	Quorum               uint            `json:"quorum"`
+	AllowVoteChange      bool            `json:"allow_vote_change"`
+	VotingWeightMode     string          `json:"voting_weight_mode"`
+	EscrowDeposit        bool            `json:"escrow_deposit"`
+	Guardians            json.RawMessage `json:"guardians"`
+	PeriodUnit           string          `json:"period_unit"`
+	ApprovalThresholds   json.RawMessage `json:"approval_thresholds"`
+	AbstainMode          string          `json:"abstain_mode"`
+	ApprovalBase         string          `json:"approval_base"`
+	AntiSnipingWindow    uint64          `json:"anti_sniping_window"`
+	AntiSnipingExtension uint64          `json:"anti_sniping_extension"`
+	RevealPeriod         uint64          `json:"reveal_period"`
+	TallyCommittee       json.RawMessage `json:"tally_committee"`
+	RelayFeeReimbursed   bool            `json:"relay_fee_reimbursed"`
+	FeeSponsorship       json.RawMessage `json:"fee_sponsorship"`
 }
 
 func (po *Policy) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -113,5 +285,19 @@ func (po *Policy) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		upo.ExecutionDelayPeriod,
 		upo.Turnout,
 		upo.Quorum,
+		upo.AllowVoteChange,
+		upo.VotingWeightMode,
+		upo.EscrowDeposit,
+		upo.Guardians,
+		upo.PeriodUnit,
+		upo.ApprovalThresholds,
+		upo.AbstainMode,
+		upo.ApprovalBase,
+		upo.AntiSnipingWindow,
+		upo.AntiSnipingExtension,
+		upo.RevealPeriod,
+		upo.TallyCommittee,
+		upo.RelayFeeReimbursed,
+		upo.FeeSponsorship,
 	)
 }
@@ -35,22 +35,61 @@ func (u URL) String() string {
 const (
 	ProposalCrypto = DAOOption("crypto")
 	ProposalBiz    = DAOOption("biz")
+	ProposalHybrid = DAOOption("hybrid")
 )
 
+const (
+	VotingPlurality    = VotingMethod("plurality")
+	VotingApproval     = VotingMethod("approval")
+	VotingRankedChoice = VotingMethod("ranked")
+)
+
+// VotingMethod decides how the ballots of a proposal are cast and tallied.
+type VotingMethod string
+
+func (m VotingMethod) IsValid([]byte) error {
+	switch m {
+	case VotingPlurality, VotingApproval, VotingRankedChoice:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid voting method; 'plurality' | 'approval' | 'ranked'")
+	}
+}
+
+func (m VotingMethod) Bytes() []byte {
+	return []byte(m)
+}
+
 var (
-	CryptoProposalHint = hint.MustNewHint("mitum-dao-crypto-proposal-v0.0.1")
-	BizProposalHint    = hint.MustNewHint("mitum-dao-biz-proposal-v0.0.1")
+	CryptoProposalHint      = hint.MustNewHint("mitum-dao-crypto-proposal-v0.0.1")
+	MultiCryptoProposalHint = hint.MustNewHint("mitum-dao-multi-crypto-proposal-v0.0.1")
+	BizProposalHint         = hint.MustNewHint("mitum-dao-biz-proposal-v0.0.1")
+	HybridProposalHint      = hint.MustNewHint("mitum-dao-hybrid-proposal-v0.0.1")
 )
 
+// MaxCallDatas is the maximum number of call data in a multi-action proposal.
+const MaxCallDatas = 20
+
 type Proposal interface {
 	util.IsValider
 	hint.Hinter
 	Option() DAOOption
 	VoteOptionsCount() uint8
+	VotingMethod() VotingMethod
 	Bytes() []byte
 	Proposer() base.Address
+	// StartTime is the start of the lifecycle in the period unit of the policy;
+	// block height in the height unit.
 	StartTime() uint64
 	Addresses() []base.Address
+	Metadata() ProposalMetadata
+}
+
+// CallDataProposal is a crypto proposal whose call data are executed in order
+// once the proposal passes.
+type CallDataProposal interface {
+	Proposal
+	CallDatas() []CallData
 }
 
 type CryptoProposal struct {
@@ -58,14 +97,16 @@ type CryptoProposal struct {
 	proposer  base.Address
 	startTime uint64
 	callData  CallData
+	metadata  ProposalMetadata
 }
 
-func NewCryptoProposal(proposer base.Address, startTime uint64, callData CallData) CryptoProposal {
+func NewCryptoProposal(proposer base.Address, startTime uint64, callData CallData, metadata ProposalMetadata) CryptoProposal {
 	return CryptoProposal{
 		BaseHinter: hint.NewBaseHinter(CryptoProposalHint),
 		proposer:   proposer,
 		startTime:  startTime,
 		callData:   callData,
+		metadata:   metadata,
 	}
 }
 
@@ -74,14 +115,21 @@ func (CryptoProposal) Option() DAOOption {
 }
 
 func (CryptoProposal) VoteOptionsCount() uint8 {
-	return 3
+	return VoteOptionAbstain + 1
 }
 
+func (CryptoProposal) VotingMethod() VotingMethod {
+	return VotingPlurality
+}
+
+// Bytes keeps the first layout for the proposals without metadata; the bytes
+// of empty metadata are empty.
 func (p CryptoProposal) Bytes() []byte {
 	return util.ConcatBytesSlice(
 		p.proposer.Bytes(),
 		util.Uint64ToBytes(p.startTime),
 		p.callData.Bytes(),
+		p.metadata.Bytes(),
 	)
 }
 
@@ -97,15 +145,28 @@ func (p CryptoProposal) CallData() CallData {
 	return p.callData
 }
 
+func (p CryptoProposal) CallDatas() []CallData {
+	return []CallData{p.callData}
+}
+
+func (p CryptoProposal) Metadata() ProposalMetadata {
+	return p.metadata
+}
+
 func (p CryptoProposal) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		p.BaseHinter,
 		p.proposer,
 		p.callData,
+		p.metadata,
 	); err != nil {
 		return util.ErrInvalid.Errorf("invalid CryptoProposal: %v", err)
 	}
 
+	if err := p.metadata.CheckOptions(p.VoteOptionsCount()); err != nil {
+		return util.ErrInvalid.Errorf("invalid CryptoProposal: %v", err)
+	}
+
 	return nil
 }
 
@@ -113,23 +174,145 @@ func (p CryptoProposal) Addresses() []base.Address {
 	return p.callData.Addresses()
 }
 
-type BizProposal struct {
+// MultiCryptoProposal is a crypto proposal with an ordered list of call data.
+// The call data are executed all together or not at all.
+type MultiCryptoProposal struct {
 	hint.BaseHinter
 	proposer  base.Address
 	startTime uint64
-	url       URL
-	hash      string
-	options   uint8
+	callDatas []CallData
+	metadata  ProposalMetadata
 }
 
-func NewBizProposal(proposer base.Address, startTime uint64, url URL, hash string, options uint8) BizProposal {
-	return BizProposal{
-		BaseHinter: hint.NewBaseHinter(BizProposalHint),
+func NewMultiCryptoProposal(
+	proposer base.Address, startTime uint64, callDatas []CallData, metadata ProposalMetadata,
+) MultiCryptoProposal {
+	return MultiCryptoProposal{
+		BaseHinter: hint.NewBaseHinter(MultiCryptoProposalHint),
 		proposer:   proposer,
 		startTime:  startTime,
-		url:        url,
-		hash:       hash,
-		options:    options,
+		callDatas:  callDatas,
+		metadata:   metadata,
+	}
+}
+
+func (MultiCryptoProposal) Option() DAOOption {
+	return ProposalCrypto
+}
+
+func (MultiCryptoProposal) VoteOptionsCount() uint8 {
+	return VoteOptionAbstain + 1
+}
+
+func (MultiCryptoProposal) VotingMethod() VotingMethod {
+	return VotingPlurality
+}
+
+func (p MultiCryptoProposal) Bytes() []byte {
+	bs := make([][]byte, len(p.callDatas)+3)
+	bs[0] = p.proposer.Bytes()
+	bs[1] = util.Uint64ToBytes(p.startTime)
+
+	for i, cd := range p.callDatas {
+		bs[i+2] = cd.Bytes()
+	}
+	bs[len(bs)-1] = p.metadata.Bytes()
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (p MultiCryptoProposal) Proposer() base.Address {
+	return p.proposer
+}
+
+func (p MultiCryptoProposal) StartTime() uint64 {
+	return p.startTime
+}
+
+func (p MultiCryptoProposal) CallDatas() []CallData {
+	return p.callDatas
+}
+
+func (p MultiCryptoProposal) Metadata() ProposalMetadata {
+	return p.metadata
+}
+
+func (p MultiCryptoProposal) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		p.BaseHinter,
+		p.proposer,
+		p.metadata,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid MultiCryptoProposal: %v", err)
+	}
+
+	if err := p.metadata.CheckOptions(p.VoteOptionsCount()); err != nil {
+		return util.ErrInvalid.Errorf("invalid MultiCryptoProposal: %v", err)
+	}
+
+	if n := len(p.callDatas); n < 1 {
+		return util.ErrInvalid.Errorf("multi crypto - empty call data")
+	} else if n > MaxCallDatas {
+		return util.ErrInvalid.Errorf("multi crypto - too many call data, %d > %d", n, MaxCallDatas)
+	}
+
+	for i, cd := range p.callDatas {
+		if err := cd.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid MultiCryptoProposal, call data %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Addresses returns the union of the addresses of the call data.
+func (p MultiCryptoProposal) Addresses() []base.Address {
+	founds := map[string]struct{}{}
+
+	var as []base.Address
+	for _, cd := range p.callDatas {
+		for _, a := range cd.Addresses() {
+			if _, found := founds[a.String()]; found {
+				continue
+			}
+
+			founds[a.String()] = struct{}{}
+			as = append(as, a)
+		}
+	}
+
+	return as
+}
+
+type BizProposal struct {
+	hint.BaseHinter
+	proposer     base.Address
+	startTime    uint64
+	url          URL
+	hash         string
+	options      uint8
+	votingMethod VotingMethod
+	metadata     ProposalMetadata
+}
+
+func NewBizProposal(
+	proposer base.Address,
+	startTime uint64,
+	url URL,
+	hash string,
+	options uint8,
+	votingMethod VotingMethod,
+	metadata ProposalMetadata,
+) BizProposal {
+	return BizProposal{
+		BaseHinter:   hint.NewBaseHinter(BizProposalHint),
+		proposer:     proposer,
+		startTime:    startTime,
+		url:          url,
+		hash:         hash,
+		options:      options,
+		votingMethod: votingMethod,
+		metadata:     metadata,
 	}
 }
 
@@ -141,13 +324,25 @@ func (p BizProposal) VoteOptionsCount() uint8 {
 	return p.options
 }
 
+func (p BizProposal) VotingMethod() VotingMethod {
+	return p.votingMethod
+}
+
+// Bytes keeps the first layout for the plurality proposals without metadata.
 func (p BizProposal) Bytes() []byte {
+	var vm []byte
+	if p.votingMethod != VotingPlurality {
+		vm = p.votingMethod.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		p.proposer.Bytes(),
 		util.Uint64ToBytes(p.startTime),
 		p.url.Bytes(),
 		[]byte(p.hash),
 		util.Uint8ToBytes(p.options),
+		vm,
+		p.metadata.Bytes(),
 	)
 }
 
@@ -167,11 +362,17 @@ func (p BizProposal) Hash() string {
 	return p.hash
 }
 
+func (p BizProposal) Metadata() ProposalMetadata {
+	return p.metadata
+}
+
 func (p BizProposal) IsValid([]byte) error {
 	if err := util.CheckIsValiders(nil, false,
 		p.BaseHinter,
 		p.proposer,
 		p.url,
+		p.votingMethod,
+		p.metadata,
 	); err != nil {
 		return util.ErrInvalid.Errorf("invalid BizProposal: %v", err)
 	}
@@ -184,6 +385,10 @@ func (p BizProposal) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("biz - zero options")
 	}
 
+	if err := p.metadata.CheckOptions(p.options); err != nil {
+		return util.ErrInvalid.Errorf("invalid BizProposal: %v", err)
+	}
+
 	return nil
 }
 
@@ -191,61 +396,211 @@ func (p BizProposal) Addresses() []base.Address {
 	return []base.Address{}
 }
 
+// HybridProposal is a proposal whose vote options are bound to call data in
+// order; option i executes call data i. The winner is decided by the rules of
+// biz proposals and only the call data of the winning option is executed. The
+// last option is abstain without call data.
+type HybridProposal struct {
+	hint.BaseHinter
+	proposer     base.Address
+	startTime    uint64
+	callDatas    []CallData
+	votingMethod VotingMethod
+	metadata     ProposalMetadata
+}
+
+func NewHybridProposal(
+	proposer base.Address,
+	startTime uint64,
+	callDatas []CallData,
+	votingMethod VotingMethod,
+	metadata ProposalMetadata,
+) HybridProposal {
+	return HybridProposal{
+		BaseHinter:   hint.NewBaseHinter(HybridProposalHint),
+		proposer:     proposer,
+		startTime:    startTime,
+		callDatas:    callDatas,
+		votingMethod: votingMethod,
+		metadata:     metadata,
+	}
+}
+
+func (HybridProposal) Option() DAOOption {
+	return ProposalHybrid
+}
+
+func (p HybridProposal) VoteOptionsCount() uint8 {
+	return uint8(len(p.callDatas) + 1)
+}
+
+func (p HybridProposal) VotingMethod() VotingMethod {
+	return p.votingMethod
+}
+
+func (p HybridProposal) Bytes() []byte {
+	bs := make([][]byte, len(p.callDatas)+4)
+	bs[0] = p.proposer.Bytes()
+	bs[1] = util.Uint64ToBytes(p.startTime)
+
+	for i, cd := range p.callDatas {
+		bs[i+2] = cd.Bytes()
+	}
+	bs[len(bs)-2] = p.votingMethod.Bytes()
+	bs[len(bs)-1] = p.metadata.Bytes()
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (p HybridProposal) Proposer() base.Address {
+	return p.proposer
+}
+
+func (p HybridProposal) StartTime() uint64 {
+	return p.startTime
+}
+
+// OptionCallDatas returns the call data of the options in the option order.
+func (p HybridProposal) OptionCallDatas() []CallData {
+	return p.callDatas
+}
+
+// OptionCallData returns the call data bound to the vote option. It returns
+// false for the abstain option.
+func (p HybridProposal) OptionCallData(o uint8) (CallData, bool) {
+	if int(o) >= len(p.callDatas) {
+		return nil, false
+	}
+
+	return p.callDatas[o], true
+}
+
+func (p HybridProposal) Metadata() ProposalMetadata {
+	return p.metadata
+}
+
+func (p HybridProposal) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		p.BaseHinter,
+		p.proposer,
+		p.votingMethod,
+		p.metadata,
+	); err != nil {
+		return util.ErrInvalid.Errorf("invalid HybridProposal: %v", err)
+	}
+
+	if n := len(p.callDatas); n < 1 {
+		return util.ErrInvalid.Errorf("hybrid - empty call data")
+	} else if n > MaxCallDatas {
+		return util.ErrInvalid.Errorf("hybrid - too many call data, %d > %d", n, MaxCallDatas)
+	}
+
+	for i, cd := range p.callDatas {
+		if err := cd.IsValid(nil); err != nil {
+			return util.ErrInvalid.Errorf("invalid HybridProposal, call data of option %d: %v", i, err)
+		}
+	}
+
+	if err := p.metadata.CheckOptions(p.VoteOptionsCount()); err != nil {
+		return util.ErrInvalid.Errorf("invalid HybridProposal: %v", err)
+	}
+
+	return nil
+}
+
+// Addresses returns the union of the addresses of the call data.
+func (p HybridProposal) Addresses() []base.Address {
+	founds := map[string]struct{}{}
+
+	var as []base.Address
+	for _, cd := range p.callDatas {
+		for _, a := range cd.Addresses() {
+			if _, found := founds[a.String()]; found {
+				continue
+			}
+
+			founds[a.String()] = struct{}{}
+			as = append(as, a)
+		}
+	}
+
+	return as
+}
+
+// LifecyclePeriods is the periods of the proposal lifecycle in time order.
+var LifecyclePeriods = []Period{
+	PreLifeCycle,
+	ProposalReview,
+	Registration,
+	PreSnapshot,
+	Voting,
+	Reveal,
+	PostSnapshot,
+	ExecutionDelay,
+	Execute,
+}
+
+// GetPeriodOfCurrentTime returns the lifecycle period of the proposal at the
+// block with the start and end of the preferred period. Periods are counted
+// in the period unit of the policy, so the start of the proposal and the
+// returned boundaries are block heights in the height unit. The voting period
+// is extended by votingExtension of the proposal. The reveal period is empty
+// for the policies without commit-reveal voting.
 func GetPeriodOfCurrentTime(
 	policy Policy,
 	proposal Proposal,
+	votingExtension uint64,
 	preferredPeriod Period,
 	blockmap base.BlockMap,
-) (Period, int64 /*period start time*/, int64 /*period end time*/) {
-	blockTime := uint64(blockmap.Manifest().ProposedAt().Unix())
+) (Period, int64 /*period start*/, int64 /*period end*/) {
+	now := policy.PeriodUnit().Now(blockmap)
+
+	currentPeriod := NilPeriod
+	for _, period := range LifecyclePeriods {
+		if _, end := GetPeriodBounds(policy, proposal, votingExtension, period); now < uint64(end) {
+			currentPeriod = period
+
+			break
+		}
+	}
+
+	preferredStart, preferredEnd := GetPeriodBounds(policy, proposal, votingExtension, preferredPeriod)
+
+	return currentPeriod, preferredStart, preferredEnd
+}
+
+// GetPeriodBounds returns the start and end of the lifecycle period of the
+// proposal in the period unit of the policy.
+func GetPeriodBounds(policy Policy, proposal Proposal, votingExtension uint64, period Period) (int64, int64) {
 	startTime := proposal.StartTime()
 	registrationTime := startTime + policy.ProposalReviewPeriod()
 	preSnapTime := registrationTime + policy.RegistrationPeriod()
 	votingTime := preSnapTime + policy.PreSnapshotPeriod()
-	postSnapTime := votingTime + policy.VotingPeriod()
+	revealTime := votingTime + policy.VotingPeriod() + votingExtension
+	postSnapTime := revealTime + policy.RevealPeriod()
 	executionDelayTime := postSnapTime + policy.PostSnapshotPeriod()
 	executeTime := executionDelayTime + policy.ExecutionDelayPeriod()
 
-	currentPeriod := NilPeriod
-	preferredStart, preferredEnd := int64(0), int64(0)
-
-	switch {
-	case blockTime < startTime:
-		currentPeriod = PreLifeCycle
-	case blockTime < registrationTime:
-		currentPeriod = ProposalReview
-	case blockTime < preSnapTime:
-		currentPeriod = Registration
-	case blockTime < votingTime:
-		currentPeriod = PreSnapshot
-	case blockTime < postSnapTime:
-		currentPeriod = Voting
-	case blockTime < executionDelayTime:
-		currentPeriod = PostSnapshot
-	case blockTime < executeTime:
-		currentPeriod = ExecutionDelay
-	case blockTime >= executeTime:
-		currentPeriod = Execute
-	}
-
-	switch preferredPeriod {
+	switch period {
 	case PreLifeCycle:
-		preferredStart, preferredEnd = 0, int64(startTime)
+		return 0, int64(startTime)
 	case ProposalReview:
-		preferredStart, preferredEnd = int64(startTime), int64(registrationTime)
+		return int64(startTime), int64(registrationTime)
 	case Registration:
-		preferredStart, preferredEnd = int64(registrationTime), int64(preSnapTime)
+		return int64(registrationTime), int64(preSnapTime)
 	case PreSnapshot:
-		preferredStart, preferredEnd = int64(preSnapTime), int64(votingTime)
+		return int64(preSnapTime), int64(votingTime)
 	case Voting:
-		preferredStart, preferredEnd = int64(votingTime), int64(postSnapTime)
+		return int64(votingTime), int64(revealTime)
+	case Reveal:
+		return int64(revealTime), int64(postSnapTime)
 	case PostSnapshot:
-		preferredStart, preferredEnd = int64(postSnapTime), int64(executionDelayTime)
+		return int64(postSnapTime), int64(executionDelayTime)
 	case ExecutionDelay:
-		preferredStart, preferredEnd = int64(executionDelayTime), int64(executeTime)
+		return int64(executionDelayTime), int64(executeTime)
 	case Execute:
-		preferredStart, preferredEnd = int64(executeTime), math.MaxInt64
+		return int64(executeTime), math.MaxInt64
+	default:
+		return 0, 0
 	}
-
-	return currentPeriod, preferredStart, preferredEnd
 }
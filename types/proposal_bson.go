@@ -14,6 +14,7 @@ func (p CryptoProposal) MarshalBSON() ([]byte, error) {
 			"proposer":   p.proposer,
 			"start_time": p.startTime,
 			"call_data":  p.callData,
+			"metadata":   p.metadata,
 		},
 	)
 }
@@ -23,6 +24,7 @@ type CryptoProposalBSONUnmarshaler struct {
 	Proposer  string   `bson:"proposer"`
 	StartTime uint64   `bson:"start_time"`
 	CallData  bson.Raw `bson:"call_data"`
+	Metadata  bson.Raw `bson:"metadata"`
 }
 
 func (p *CryptoProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -38,29 +40,69 @@ func (p *CryptoProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.CallData)
+	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.CallData, up.Metadata)
 }
 
-func (p BizProposal) MarshalBSON() ([]byte, error) {
+func (p MultiCryptoProposal) MarshalBSON() ([]byte, error) {
 	return bsonenc.Marshal(
 		bson.M{
 			"_hint":      p.Hint().String(),
 			"proposer":   p.proposer,
 			"start_time": p.startTime,
-			"url":        p.url,
-			"hash":       p.hash,
-			"options":    p.options,
+			"call_datas": p.callDatas,
+			"metadata":   p.metadata,
+		},
+	)
+}
+
+type MultiCryptoProposalBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Proposer  string   `bson:"proposer"`
+	StartTime uint64   `bson:"start_time"`
+	CallDatas bson.Raw `bson:"call_datas"`
+	Metadata  bson.Raw `bson:"metadata"`
+}
+
+func (p *MultiCryptoProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of MultiCryptoProposal")
+
+	var up MultiCryptoProposalBSONUnmarshaler
+	if err := enc.Unmarshal(b, &up); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(up.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.CallDatas, up.Metadata)
+}
+
+func (p BizProposal) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         p.Hint().String(),
+			"proposer":      p.proposer,
+			"start_time":    p.startTime,
+			"url":           p.url,
+			"hash":          p.hash,
+			"options":       p.options,
+			"voting_method": p.votingMethod,
+			"metadata":      p.metadata,
 		},
 	)
 }
 
 type BizProposalBSONUnmarshaler struct {
-	Hint      string `bson:"_hint"`
-	Proposer  string `bson:"proposer"`
-	StartTime uint64 `bson:"start_time"`
-	Url       string `bson:"url"`
-	Hash      string `bson:"hash"`
-	Options   uint8  `bson:"options"`
+	Hint      string   `bson:"_hint"`
+	Proposer  string   `bson:"proposer"`
+	StartTime uint64   `bson:"start_time"`
+	Url       string   `bson:"url"`
+	Hash      string   `bson:"hash"`
+	Options   uint8    `bson:"options"`
+	Method    string   `bson:"voting_method"`
+	Metadata  bson.Raw `bson:"metadata"`
 }
 
 func (p *BizProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -76,5 +118,43 @@ func (p *BizProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options)
+	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options, up.Method, up.Metadata)
+}
+
+func (p HybridProposal) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         p.Hint().String(),
+			"proposer":      p.proposer,
+			"start_time":    p.startTime,
+			"call_datas":    p.callDatas,
+			"voting_method": p.votingMethod,
+			"metadata":      p.metadata,
+		},
+	)
+}
+
+type HybridProposalBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Proposer  string   `bson:"proposer"`
+	StartTime uint64   `bson:"start_time"`
+	CallDatas bson.Raw `bson:"call_datas"`
+	Method    string   `bson:"voting_method"`
+	Metadata  bson.Raw `bson:"metadata"`
+}
+
+func (p *HybridProposal) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of HybridProposal")
+
+	var up HybridProposalBSONUnmarshaler
+	if err := enc.Unmarshal(b, &up); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(up.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, ht, up.Proposer, up.StartTime, up.CallDatas, up.Method, up.Metadata)
 }
@@ -8,7 +8,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (p *CryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, bcd []byte) error {
+func (p *CryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, bcd, bm []byte) error {
 	e := util.StringError("failed to unmarshal CryptoProposal")
 
 	p.BaseHinter = hint.NewBaseHinter(ht)
@@ -29,10 +29,56 @@ func (p *CryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st
 		p.callData = cd
 	}
 
+	metadata, err := decodeProposalMetadata(enc, bm)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	p.metadata = metadata
+
+	return nil
+}
+
+func (p *MultiCryptoProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, bcds, bm []byte) error {
+	e := util.StringError("failed to unmarshal MultiCryptoProposal")
+
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.startTime = st
+
+	switch a, err := base.DecodeAddress(pr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		p.proposer = a
+	}
+
+	hr, err := enc.DecodeSlice(bcds)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	cds := make([]CallData, len(hr))
+	for i, hinter := range hr {
+		cd, ok := hinter.(CallData)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected CallData, not %T", hinter))
+		}
+
+		cds[i] = cd
+	}
+	p.callDatas = cds
+
+	metadata, err := decodeProposalMetadata(enc, bm)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	p.metadata = metadata
+
 	return nil
 }
 
-func (p *BizProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st uint64, url, hash string, opt uint8) error {
+func (p *BizProposal) unpack(
+	enc encoder.Encoder, ht hint.Hint, pr string, st uint64, url, hash string, opt uint8, vm string, bm []byte,
+) error {
 	e := util.StringError("failed to unmarshal BizProposal")
 
 	p.BaseHinter = hint.NewBaseHinter(ht)
@@ -42,6 +88,42 @@ func (p *BizProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st ui
 	p.hash = hash
 	p.options = opt
 
+	if len(vm) < 1 {
+		p.votingMethod = VotingPlurality
+	} else {
+		p.votingMethod = VotingMethod(vm)
+	}
+
+	switch a, err := base.DecodeAddress(pr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		p.proposer = a
+	}
+
+	metadata, err := decodeProposalMetadata(enc, bm)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	p.metadata = metadata
+
+	return nil
+}
+
+func (p *HybridProposal) unpack(
+	enc encoder.Encoder, ht hint.Hint, pr string, st uint64, bcds []byte, vm string, bm []byte,
+) error {
+	e := util.StringError("failed to unmarshal HybridProposal")
+
+	p.BaseHinter = hint.NewBaseHinter(ht)
+	p.startTime = st
+
+	if len(vm) < 1 {
+		p.votingMethod = VotingPlurality
+	} else {
+		p.votingMethod = VotingMethod(vm)
+	}
+
 	switch a, err := base.DecodeAddress(pr, enc); {
 	case err != nil:
 		return e.Wrap(err)
@@ -49,5 +131,45 @@ func (p *BizProposal) unpack(enc encoder.Encoder, ht hint.Hint, pr string, st ui
 		p.proposer = a
 	}
 
+	hr, err := enc.DecodeSlice(bcds)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	cds := make([]CallData, len(hr))
+	for i, hinter := range hr {
+		cd, ok := hinter.(CallData)
+		if !ok {
+			return e.Wrap(errors.Errorf("expected CallData, not %T", hinter))
+		}
+
+		cds[i] = cd
+	}
+	p.callDatas = cds
+
+	metadata, err := decodeProposalMetadata(enc, bm)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	p.metadata = metadata
+
 	return nil
 }
+
+// decodeProposalMetadata decodes the metadata of a proposal; proposals without
+// metadata are decoded with empty metadata.
+func decodeProposalMetadata(enc encoder.Encoder, b []byte) (ProposalMetadata, error) {
+	switch hinter, err := enc.Decode(b); {
+	case err != nil:
+		return ProposalMetadata{}, err
+	case hinter == nil:
+		return NewProposalMetadata("", "", nil, "", ""), nil
+	default:
+		m, ok := hinter.(ProposalMetadata)
+		if !ok {
+			return ProposalMetadata{}, errors.Errorf("expected ProposalMetadata, not %T", hinter)
+		}
+
+		return m, nil
+	}
+}
@@ -11,9 +11,10 @@ import (
 
 type CryptoProposalJSONMarshaler struct {
 	hint.BaseHinter
-	Proposer  base.Address `json:"proposer"`
-	StartTime uint64       `json:"start_time"`
-	CallData  CallData     `json:"call_data"`
+	Proposer  base.Address     `json:"proposer"`
+	StartTime uint64           `json:"start_time"`
+	CallData  CallData         `json:"call_data"`
+	Metadata  ProposalMetadata `json:"metadata"`
 }
 
 func (p CryptoProposal) MarshalJSON() ([]byte, error) {
@@ -22,6 +23,7 @@ func (p CryptoProposal) MarshalJSON() ([]byte, error) {
 		Proposer:   p.proposer,
 		CallData:   p.callData,
 		StartTime:  p.startTime,
+		Metadata:   p.metadata,
 	})
 }
 
@@ -30,6 +32,7 @@ type CryptoProposalJSONUnmarshaler struct {
 	Proposer  string          `json:"proposer"`
 	StartTime uint64          `json:"start_time"`
 	CallData  json.RawMessage `json:"call_data"`
+	Metadata  json.RawMessage `json:"metadata"`
 }
 
 func (p *CryptoProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -40,16 +43,55 @@ func (p *CryptoProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.CallData)
+	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.CallData, up.Metadata)
+}
+
+type MultiCryptoProposalJSONMarshaler struct {
+	hint.BaseHinter
+	Proposer  base.Address     `json:"proposer"`
+	StartTime uint64           `json:"start_time"`
+	CallDatas []CallData       `json:"call_datas"`
+	Metadata  ProposalMetadata `json:"metadata"`
+}
+
+func (p MultiCryptoProposal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(MultiCryptoProposalJSONMarshaler{
+		BaseHinter: p.BaseHinter,
+		Proposer:   p.proposer,
+		StartTime:  p.startTime,
+		CallDatas:  p.callDatas,
+		Metadata:   p.metadata,
+	})
+}
+
+type MultiCryptoProposalJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Proposer  string          `json:"proposer"`
+	StartTime uint64          `json:"start_time"`
+	CallDatas json.RawMessage `json:"call_datas"`
+	Metadata  json.RawMessage `json:"metadata"`
+}
+
+func (p *MultiCryptoProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of MultiCryptoProposal")
+
+	var up MultiCryptoProposalJSONUnmarshaler
+	if err := enc.Unmarshal(b, &up); err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.CallDatas, up.Metadata)
 }
 
 type BizProposalJSONMarshaler struct {
 	hint.BaseHinter
-	Proposer  base.Address `json:"proposer"`
-	StartTime uint64       `json:"start_time"`
-	Url       URL          `json:"url"`
-	Hash      string       `json:"hash"`
-	Options   uint8        `json:"options"`
+	Proposer  base.Address     `json:"proposer"`
+	StartTime uint64           `json:"start_time"`
+	Url       URL              `json:"url"`
+	Hash      string           `json:"hash"`
+	Options   uint8            `json:"options"`
+	Method    VotingMethod     `json:"voting_method"`
+	Metadata  ProposalMetadata `json:"metadata"`
 }
 
 func (p BizProposal) MarshalJSON() ([]byte, error) {
@@ -60,16 +102,20 @@ func (p BizProposal) MarshalJSON() ([]byte, error) {
 		Url:        p.url,
 		Hash:       p.hash,
 		Options:    p.options,
+		Method:     p.votingMethod,
+		Metadata:   p.metadata,
 	})
 }
 
 type BizProposalJSONUnmarshaler struct {
-	Hint      hint.Hint `json:"_hint"`
-	Proposer  string    `json:"proposer"`
-	StartTime uint64    `json:"start_time"`
-	Url       string    `json:"url"`
-	Hash      string    `json:"hash"`
-	Options   uint8     `json:"options"`
+	Hint      hint.Hint       `json:"_hint"`
+	Proposer  string          `json:"proposer"`
+	StartTime uint64          `json:"start_time"`
+	Url       string          `json:"url"`
+	Hash      string          `json:"hash"`
+	Options   uint8           `json:"options"`
+	Method    string          `json:"voting_method"`
+	Metadata  json.RawMessage `json:"metadata"`
 }
 
 func (p *BizProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -80,5 +126,45 @@ func (p *BizProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options)
+	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.Url, up.Hash, up.Options, up.Method, up.Metadata)
+}
+
+type HybridProposalJSONMarshaler struct {
+	hint.BaseHinter
+	Proposer  base.Address     `json:"proposer"`
+	StartTime uint64           `json:"start_time"`
+	CallDatas []CallData       `json:"call_datas"`
+	Method    VotingMethod     `json:"voting_method"`
+	Metadata  ProposalMetadata `json:"metadata"`
+}
+
+func (p HybridProposal) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(HybridProposalJSONMarshaler{
+		BaseHinter: p.BaseHinter,
+		Proposer:   p.proposer,
+		StartTime:  p.startTime,
+		CallDatas:  p.callDatas,
+		Method:     p.votingMethod,
+		Metadata:   p.metadata,
+	})
+}
+
+type HybridProposalJSONUnmarshaler struct {
+	Hint      hint.Hint       `json:"_hint"`
+	Proposer  string          `json:"proposer"`
+	StartTime uint64          `json:"start_time"`
+	CallDatas json.RawMessage `json:"call_datas"`
+	Method    string          `json:"voting_method"`
+	Metadata  json.RawMessage `json:"metadata"`
+}
+
+func (p *HybridProposal) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of HybridProposal")
+
+	var up HybridProposalJSONUnmarshaler
+	if err := enc.Unmarshal(b, &up); err != nil {
+		return e.Wrap(err)
+	}
+
+	return p.unpack(enc, up.Hint, up.Proposer, up.StartTime, up.CallDatas, up.Method, up.Metadata)
 }
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+const (
+	HashAlgorithmSHA256    = HashAlgorithm("sha256")
+	HashAlgorithmMultihash = HashAlgorithm("multihash")
+)
+
+// HashAlgorithm tags the content hash of the proposal document. sha256 is
+// the hex encoded sha256 digest and multihash is the hex encoded multihash.
+type HashAlgorithm string
+
+func (a HashAlgorithm) IsValid([]byte) error {
+	switch a {
+	case HashAlgorithmSHA256, HashAlgorithmMultihash:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("invalid hash algorithm; 'sha256' | 'multihash'")
+	}
+}
+
+func (a HashAlgorithm) Bytes() []byte {
+	return []byte(a)
+}
+
+// CheckHash checks the hex encoded content hash is the digest of the algorithm.
+func (a HashAlgorithm) CheckHash(h string) error {
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return util.ErrInvalid.Errorf("content hash is not hex encoded: %v", err)
+	}
+
+	switch a {
+	case HashAlgorithmSHA256:
+		if len(b) != 32 {
+			return util.ErrInvalid.Errorf("sha256 content hash must be 32 bytes, not %d", len(b))
+		}
+	case HashAlgorithmMultihash:
+		_, n := binary.Uvarint(b)
+		if n <= 0 {
+			return util.ErrInvalid.Errorf("invalid multihash code")
+		}
+
+		l, m := binary.Uvarint(b[n:])
+		if m <= 0 {
+			return util.ErrInvalid.Errorf("invalid multihash length")
+		}
+
+		if d := len(b[n+m:]); l < 1 || uint64(d) != l {
+			return util.ErrInvalid.Errorf("multihash digest length mismatch, %d != %d", d, l)
+		}
+	default:
+		return a.IsValid(nil)
+	}
+
+	return nil
+}
+
+const (
+	MaxProposalTitleLength       = 200
+	MaxProposalSummaryLength     = 2000
+	MaxProposalOptionLabelLength = 100
+)
+
+var ProposalMetadataHint = hint.MustNewHint("mitum-dao-proposal-metadata-v0.0.1")
+
+// ProposalMetadata is the human readable description of a proposal with the
+// hash of its off-chain document. The option labels, if any, name the vote
+// options of the proposal in order. Every field is optional, but the content
+// hash requires the hash algorithm.
+type ProposalMetadata struct {
+	hint.BaseHinter
+	title         string
+	summary       string
+	optionLabels  []string
+	hashAlgorithm HashAlgorithm
+	contentHash   string
+}
+
+func NewProposalMetadata(
+	title, summary string, optionLabels []string, hashAlgorithm HashAlgorithm, contentHash string,
+) ProposalMetadata {
+	return ProposalMetadata{
+		BaseHinter:    hint.NewBaseHinter(ProposalMetadataHint),
+		title:         title,
+		summary:       summary,
+		optionLabels:  optionLabels,
+		hashAlgorithm: hashAlgorithm,
+		contentHash:   contentHash,
+	}
+}
+
+func (m ProposalMetadata) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ProposalMetadata")
+
+	if err := m.BaseHinter.IsValid(ProposalMetadataHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if n := utf8.RuneCountInString(m.title); n > MaxProposalTitleLength {
+		return e.Wrap(errors.Errorf("title too long, %d > %d", n, MaxProposalTitleLength))
+	}
+
+	if n := utf8.RuneCountInString(m.summary); n > MaxProposalSummaryLength {
+		return e.Wrap(errors.Errorf("summary too long, %d > %d", n, MaxProposalSummaryLength))
+	}
+
+	founds := map[string]struct{}{}
+	for i, label := range m.optionLabels {
+		switch n := utf8.RuneCountInString(label); {
+		case n < 1:
+			return e.Wrap(errors.Errorf("empty option label, %d", i))
+		case n > MaxProposalOptionLabelLength:
+			return e.Wrap(errors.Errorf("option label %d too long, %d > %d", i, n, MaxProposalOptionLabelLength))
+		}
+
+		if _, found := founds[label]; found {
+			return e.Wrap(errors.Errorf("duplicate option label, %q", label))
+		}
+		founds[label] = struct{}{}
+	}
+
+	switch {
+	case len(m.contentHash) < 1 && len(m.hashAlgorithm) < 1:
+	case len(m.contentHash) < 1:
+		return e.Wrap(errors.Errorf("hash algorithm without content hash"))
+	default:
+		if err := m.hashAlgorithm.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if err := m.hashAlgorithm.CheckHash(m.contentHash); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// CheckOptions checks the option labels name every vote option of the proposal.
+func (m ProposalMetadata) CheckOptions(options uint8) error {
+	if n := len(m.optionLabels); n > 0 && n != int(options) {
+		return util.ErrInvalid.Errorf("option labels not match with vote options, %d != %d", n, options)
+	}
+
+	return nil
+}
+
+func (m ProposalMetadata) Bytes() []byte {
+	bs := make([][]byte, len(m.optionLabels)+4)
+	bs[0] = []byte(m.title)
+	bs[1] = []byte(m.summary)
+	bs[2] = m.hashAlgorithm.Bytes()
+	bs[3] = []byte(m.contentHash)
+
+	for i, label := range m.optionLabels {
+		bs[i+4] = []byte(label)
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (m ProposalMetadata) Title() string {
+	return m.title
+}
+
+func (m ProposalMetadata) Summary() string {
+	return m.summary
+}
+
+func (m ProposalMetadata) OptionLabels() []string {
+	return m.optionLabels
+}
+
+func (m ProposalMetadata) HashAlgorithm() HashAlgorithm {
+	return m.hashAlgorithm
+}
+
+func (m ProposalMetadata) ContentHash() string {
+	return m.contentHash
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (m ProposalMetadata) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":          m.Hint().String(),
+			"title":          m.title,
+			"summary":        m.summary,
+			"option_labels":  m.optionLabels,
+			"hash_algorithm": m.hashAlgorithm,
+			"content_hash":   m.contentHash,
+		},
+	)
+}
+
+type ProposalMetadataBSONUnmarshaler struct {
+	Hint          string   `bson:"_hint"`
+	Title         string   `bson:"title"`
+	Summary       string   `bson:"summary"`
+	OptionLabels  []string `bson:"option_labels"`
+	HashAlgorithm string   `bson:"hash_algorithm"`
+	ContentHash   string   `bson:"content_hash"`
+}
+
+func (m *ProposalMetadata) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalMetadata")
+
+	var u ProposalMetadataBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return m.unpack(ht, u.Title, u.Summary, u.OptionLabels, u.HashAlgorithm, u.ContentHash)
+}
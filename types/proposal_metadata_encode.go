@@ -0,0 +1,16 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (m *ProposalMetadata) unpack(ht hint.Hint, ti, su string, ols []string, ha, ch string) error {
+	m.BaseHinter = hint.NewBaseHinter(ht)
+	m.title = ti
+	m.summary = su
+	m.optionLabels = ols
+	m.hashAlgorithm = HashAlgorithm(ha)
+	m.contentHash = ch
+
+	return nil
+}
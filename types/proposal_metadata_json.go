@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ProposalMetadataJSONMarshaler struct {
+	hint.BaseHinter
+	Title         string        `json:"title"`
+	Summary       string        `json:"summary"`
+	OptionLabels  []string      `json:"option_labels"`
+	HashAlgorithm HashAlgorithm `json:"hash_algorithm"`
+	ContentHash   string        `json:"content_hash"`
+}
+
+func (m ProposalMetadata) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposalMetadataJSONMarshaler{
+		BaseHinter:    m.BaseHinter,
+		Title:         m.title,
+		Summary:       m.summary,
+		OptionLabels:  m.optionLabels,
+		HashAlgorithm: m.hashAlgorithm,
+		ContentHash:   m.contentHash,
+	})
+}
+
+type ProposalMetadataJSONUnmarshaler struct {
+	Hint          hint.Hint `json:"_hint"`
+	Title         string    `json:"title"`
+	Summary       string    `json:"summary"`
+	OptionLabels  []string  `json:"option_labels"`
+	HashAlgorithm string    `json:"hash_algorithm"`
+	ContentHash   string    `json:"content_hash"`
+}
+
+func (m *ProposalMetadata) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalMetadata")
+
+	var u ProposalMetadataJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return m.unpack(u.Hint, u.Title, u.Summary, u.OptionLabels, u.HashAlgorithm, u.ContentHash)
+}
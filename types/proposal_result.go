@@ -0,0 +1,188 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var ProposalResultHint = hint.MustNewHint("mitum-dao-proposal-result-v0.0.1")
+
+// ProposalResult is the record of the result of a finished proposal. It keeps
+// the figures the result status was decided by, so the result can be audited
+// without counting the votes again. totalSupply is the voting power token
+// supply the turnout threshold is measured against and quorumTotal is the part
+// of the voted total the quorum threshold is measured against. The winner is
+// the passing option of a completed proposal; for of crypto proposals. A
+// vetoed proposal keeps the figures of the tally and a proposal canceled before
+// the tally keeps the figures known at the time, zero for the others.
+type ProposalResult struct {
+	hint.BaseHinter
+	status           ProposalStatus
+	totalSupply      common.Big
+	turnout          common.Big
+	turnoutThreshold common.Big
+	votedTotal       common.Big
+	quorumTotal      common.Big
+	quorumThreshold  common.Big
+	optionTotals     map[uint8]common.Big
+	winner           uint8
+	elected          bool
+	rule             string
+	height           base.Height
+}
+
+func NewProposalResult(
+	status ProposalStatus,
+	totalSupply, turnout, turnoutThreshold common.Big,
+	votedTotal, quorumTotal, quorumThreshold common.Big,
+	optionTotals map[uint8]common.Big,
+	winner uint8,
+	elected bool,
+	rule string,
+	height base.Height,
+) ProposalResult {
+	return ProposalResult{
+		BaseHinter:       hint.NewBaseHinter(ProposalResultHint),
+		status:           status,
+		totalSupply:      totalSupply,
+		turnout:          turnout,
+		turnoutThreshold: turnoutThreshold,
+		votedTotal:       votedTotal,
+		quorumTotal:      quorumTotal,
+		quorumThreshold:  quorumThreshold,
+		optionTotals:     optionTotals,
+		winner:           winner,
+		elected:          elected,
+		rule:             rule,
+		height:           height,
+	}
+}
+
+func (r ProposalResult) Hint() hint.Hint {
+	return r.BaseHinter.Hint()
+}
+
+func (r ProposalResult) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid ProposalResult")
+
+	if err := r.BaseHinter.IsValid(ProposalResultHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	switch r.status {
+	case Completed, Rejected, Canceled, Vetoed:
+	default:
+		return e.Wrap(errors.Errorf("not a result status, %d", r.status))
+	}
+
+	if err := util.CheckIsValiders(nil, false,
+		r.totalSupply,
+		r.turnout,
+		r.turnoutThreshold,
+		r.votedTotal,
+		r.quorumTotal,
+		r.quorumThreshold,
+		r.height,
+	); err != nil {
+		return e.Wrap(err)
+	}
+
+	for o, am := range r.optionTotals {
+		if err := am.IsValid(nil); err != nil {
+			return e.Wrap(errors.Errorf("invalid total of option %d: %v", o, err))
+		}
+	}
+
+	if r.elected && r.status != Completed {
+		return e.Wrap(errors.Errorf("winner of not completed proposal"))
+	}
+
+	if len(r.rule) < 1 {
+		return e.Wrap(errors.Errorf("empty rule"))
+	}
+
+	return nil
+}
+
+func (r ProposalResult) Bytes() []byte {
+	options := make([]int, 0, len(r.optionTotals))
+	for o := range r.optionTotals {
+		options = append(options, int(o))
+	}
+	sort.Ints(options)
+
+	bs := make([][]byte, len(options))
+	for i, o := range options {
+		bs[i] = util.ConcatBytesSlice(util.Uint8ToBytes(uint8(o)), r.optionTotals[uint8(o)].Bytes())
+	}
+
+	return util.ConcatBytesSlice(
+		r.status.Bytes(),
+		r.totalSupply.Bytes(),
+		r.turnout.Bytes(),
+		r.turnoutThreshold.Bytes(),
+		r.votedTotal.Bytes(),
+		r.quorumTotal.Bytes(),
+		r.quorumThreshold.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		util.Uint8ToBytes(r.winner),
+		util.BoolToBytes(r.elected),
+		[]byte(r.rule),
+		r.height.Bytes(),
+	)
+}
+
+func (r ProposalResult) Status() ProposalStatus {
+	return r.status
+}
+
+func (r ProposalResult) TotalSupply() common.Big {
+	return r.totalSupply
+}
+
+// Turnout is the total locked amount of the voting powers of the proposal.
+func (r ProposalResult) Turnout() common.Big {
+	return r.turnout
+}
+
+func (r ProposalResult) TurnoutThreshold() common.Big {
+	return r.turnoutThreshold
+}
+
+func (r ProposalResult) VotedTotal() common.Big {
+	return r.votedTotal
+}
+
+func (r ProposalResult) QuorumTotal() common.Big {
+	return r.quorumTotal
+}
+
+func (r ProposalResult) QuorumThreshold() common.Big {
+	return r.quorumThreshold
+}
+
+func (r ProposalResult) OptionTotals() map[uint8]common.Big {
+	return r.optionTotals
+}
+
+// Winner returns the winning option. It returns false when no option won.
+func (r ProposalResult) Winner() (uint8, bool) {
+	return r.winner, r.elected
+}
+
+// Rule returns the rule which decided the result; the approval threshold
+// calldata type, ApprovalRuleQuorum, ApprovalRuleTurnout, ApprovalRuleCancel,
+// ApprovalRuleVeto or ApprovalRuleDecryption.
+func (r ProposalResult) Rule() string {
+	return r.rule
+}
+
+// Height is the block height where the result is decided.
+func (r ProposalResult) Height() base.Height {
+	return r.height
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r ProposalResult) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":             r.Hint().String(),
+			"status":            r.status,
+			"total_supply":      r.totalSupply.String(),
+			"turnout":           r.turnout.String(),
+			"turnout_threshold": r.turnoutThreshold.String(),
+			"voted_total":       r.votedTotal.String(),
+			"quorum_total":      r.quorumTotal.String(),
+			"quorum_threshold":  r.quorumThreshold.String(),
+			"option_totals":     allocationsToStrings(r.optionTotals),
+			"winner":            r.winner,
+			"elected":           r.elected,
+			"rule":              r.rule,
+			"height":            r.height.Int64(),
+		},
+	)
+}
+
+type ProposalResultBSONUnmarshaler struct {
+	Hint             string           `bson:"_hint"`
+	Status           uint8            `bson:"status"`
+	TotalSupply      string           `bson:"total_supply"`
+	Turnout          string           `bson:"turnout"`
+	TurnoutThreshold string           `bson:"turnout_threshold"`
+	VotedTotal       string           `bson:"voted_total"`
+	QuorumTotal      string           `bson:"quorum_total"`
+	QuorumThreshold  string           `bson:"quorum_threshold"`
+	OptionTotals     map[uint8]string `bson:"option_totals"`
+	Winner           uint8            `bson:"winner"`
+	Elected          bool             `bson:"elected"`
+	Rule             string           `bson:"rule"`
+	Height           int64            `bson:"height"`
+}
+
+func (r *ProposalResult) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of ProposalResult")
+
+	var u ProposalResultBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.Status,
+		u.TotalSupply, u.Turnout, u.TurnoutThreshold,
+		u.VotedTotal, u.QuorumTotal, u.QuorumThreshold,
+		u.OptionTotals, u.Winner, u.Elected, u.Rule, u.Height,
+	)
+}
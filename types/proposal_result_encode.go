@@ -0,0 +1,60 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *ProposalResult) unpack(_ encoder.Encoder, ht hint.Hint,
+	st uint8,
+	ts, to, tt string,
+	vt, qt, qth string,
+	ots map[uint8]string,
+	wn uint8,
+	el bool,
+	rl string,
+	h int64,
+) error {
+	e := util.StringError("failed to unmarshal ProposalResult")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.status = ProposalStatus(st)
+
+	for _, f := range []struct {
+		s string
+		b *common.Big
+	}{
+		{ts, &r.totalSupply},
+		{to, &r.turnout},
+		{tt, &r.turnoutThreshold},
+		{vt, &r.votedTotal},
+		{qt, &r.quorumTotal},
+		{qth, &r.quorumThreshold},
+	} {
+		big, err := common.NewBigFromString(f.s)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		*f.b = big
+	}
+
+	totals, err := allocationsFromStrings(ots)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if totals == nil {
+		totals = map[uint8]common.Big{}
+	}
+	r.optionTotals = totals
+
+	r.winner = wn
+	r.elected = el
+	r.rule = rl
+	r.height = base.Height(h)
+
+	return nil
+}
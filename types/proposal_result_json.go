@@ -0,0 +1,73 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type ProposalResultJSONMarshaler struct {
+	hint.BaseHinter
+	Status           ProposalStatus   `json:"status"`
+	TotalSupply      string           `json:"total_supply"`
+	Turnout          string           `json:"turnout"`
+	TurnoutThreshold string           `json:"turnout_threshold"`
+	VotedTotal       string           `json:"voted_total"`
+	QuorumTotal      string           `json:"quorum_total"`
+	QuorumThreshold  string           `json:"quorum_threshold"`
+	OptionTotals     map[uint8]string `json:"option_totals"`
+	Winner           uint8            `json:"winner"`
+	Elected          bool             `json:"elected"`
+	Rule             string           `json:"rule"`
+	Height           base.Height      `json:"height"`
+}
+
+func (r ProposalResult) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ProposalResultJSONMarshaler{
+		BaseHinter:       r.BaseHinter,
+		Status:           r.status,
+		TotalSupply:      r.totalSupply.String(),
+		Turnout:          r.turnout.String(),
+		TurnoutThreshold: r.turnoutThreshold.String(),
+		VotedTotal:       r.votedTotal.String(),
+		QuorumTotal:      r.quorumTotal.String(),
+		QuorumThreshold:  r.quorumThreshold.String(),
+		OptionTotals:     allocationsToStrings(r.optionTotals),
+		Winner:           r.winner,
+		Elected:          r.elected,
+		Rule:             r.rule,
+		Height:           r.height,
+	})
+}
+
+type ProposalResultJSONUnmarshaler struct {
+	Hint             hint.Hint        `json:"_hint"`
+	Status           uint8            `json:"status"`
+	TotalSupply      string           `json:"total_supply"`
+	Turnout          string           `json:"turnout"`
+	TurnoutThreshold string           `json:"turnout_threshold"`
+	VotedTotal       string           `json:"voted_total"`
+	QuorumTotal      string           `json:"quorum_total"`
+	QuorumThreshold  string           `json:"quorum_threshold"`
+	OptionTotals     map[uint8]string `json:"option_totals"`
+	Winner           uint8            `json:"winner"`
+	Elected          bool             `json:"elected"`
+	Rule             string           `json:"rule"`
+	Height           int64            `json:"height"`
+}
+
+func (r *ProposalResult) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of ProposalResult")
+
+	var u ProposalResultJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.Status,
+		u.TotalSupply, u.Turnout, u.TurnoutThreshold,
+		u.VotedTotal, u.QuorumTotal, u.QuorumThreshold,
+		u.OptionTotals, u.Winner, u.Elected, u.Rule, u.Height,
+	)
+}
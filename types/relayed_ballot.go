@@ -0,0 +1,127 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var RelayedBallotHint = hint.MustNewHint("mitum-dao-relayed-ballot-v0.0.1")
+
+// RelayedBallot is a vote signed offline by the voter and submitted by a
+// relayer. The signs are made over RelayedBallotMessage with the network id, so
+// the ballot is bound to the proposal and can not be replayed on another
+// network. A relayed ballot is accepted only for a voter who has not voted, so
+// it can not be replayed over a later vote of the voter.
+type RelayedBallot struct {
+	hint.BaseHinter
+	voter base.Address
+	vote  uint8
+	signs []base.Sign
+}
+
+func NewRelayedBallot(voter base.Address, vote uint8, signs []base.Sign) RelayedBallot {
+	return RelayedBallot{
+		BaseHinter: hint.NewBaseHinter(RelayedBallotHint),
+		voter:      voter,
+		vote:       vote,
+		signs:      signs,
+	}
+}
+
+// SignRelayedBallot signs the vote of the voter for the proposal of the
+// contract by the private key.
+func SignRelayedBallot(
+	priv base.Privatekey, networkID base.NetworkID, voter, contract base.Address, proposalID string, vote uint8,
+) (RelayedBallot, error) {
+	sign, err := base.NewBaseSignFromBytes(priv, networkID, RelayedBallotMessage(contract, proposalID, vote))
+	if err != nil {
+		return RelayedBallot{}, err
+	}
+
+	return NewRelayedBallot(voter, vote, []base.Sign{sign}), nil
+}
+
+// RelayedBallotMessage returns the message signed by the voter.
+func RelayedBallotMessage(contract base.Address, proposalID string, vote uint8) []byte {
+	return util.ConcatBytesSlice(
+		contract.Bytes(),
+		[]byte(proposalID),
+		util.Uint8ToBytes(vote),
+	)
+}
+
+func (rb RelayedBallot) Hint() hint.Hint {
+	return rb.BaseHinter.Hint()
+}
+
+func (rb RelayedBallot) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid RelayedBallot")
+
+	if err := rb.BaseHinter.IsValid(RelayedBallotHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := rb.voter.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if len(rb.signs) < 1 {
+		return e.Wrap(errors.Errorf("empty signs"))
+	}
+
+	signers := map[string]struct{}{}
+	for i := range rb.signs {
+		if err := rb.signs[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		signer := rb.signs[i].Signer().String()
+		if _, found := signers[signer]; found {
+			return e.Wrap(errors.Errorf("duplicated signer, %s", signer))
+		}
+		signers[signer] = struct{}{}
+	}
+
+	return nil
+}
+
+func (rb RelayedBallot) Bytes() []byte {
+	bs := make([][]byte, len(rb.signs))
+	for i := range rb.signs {
+		bs[i] = rb.signs[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		rb.voter.Bytes(),
+		util.Uint8ToBytes(rb.vote),
+		util.ConcatBytesSlice(bs...),
+	)
+}
+
+func (rb RelayedBallot) Voter() base.Address {
+	return rb.voter
+}
+
+func (rb RelayedBallot) Vote() uint8 {
+	return rb.vote
+}
+
+func (rb RelayedBallot) Signs() []base.Sign {
+	return rb.signs
+}
+
+// Verify verifies the signs of the ballot for the proposal of the contract.
+// The signers are checked against the keys of the voter account separately.
+func (rb RelayedBallot) Verify(networkID base.NetworkID, contract base.Address, proposalID string) error {
+	msg := RelayedBallotMessage(contract, proposalID, rb.vote)
+
+	for i := range rb.signs {
+		if err := rb.signs[i].Verify(networkID, msg); err != nil {
+			return errors.Wrapf(err, "signer %s", rb.signs[i].Signer())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (rb RelayedBallot) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": rb.Hint().String(),
+			"voter": rb.voter,
+			"vote":  rb.vote,
+			"signs": MarshalSignsBSON(rb.signs),
+		},
+	)
+}
+
+type RelayedBallotBSONUnmarshaler struct {
+	Hint  string                `bson:"_hint"`
+	Voter string                `bson:"voter"`
+	Vote  uint8                 `bson:"vote"`
+	Signs []SignBSONUnmarshaler `bson:"signs"`
+}
+
+func (rb *RelayedBallot) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RelayedBallot")
+
+	var u RelayedBallotBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	signs, err := UnmarshalSignsBSON(u.Signs, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return rb.unpack(enc, ht, u.Voter, u.Vote, signs)
+}
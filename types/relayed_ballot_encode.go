@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (rb *RelayedBallot) unpack(enc encoder.Encoder, ht hint.Hint, va string, vt uint8, signs []base.Sign) error {
+	e := util.StringError("failed to unmarshal RelayedBallot")
+
+	rb.BaseHinter = hint.NewBaseHinter(ht)
+	rb.vote = vt
+	rb.signs = signs
+
+	switch a, err := base.DecodeAddress(va, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		rb.voter = a
+	}
+
+	return nil
+}
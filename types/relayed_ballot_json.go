@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RelayedBallotJSONMarshaler struct {
+	hint.BaseHinter
+	Voter base.Address `json:"voter"`
+	Vote  uint8        `json:"vote"`
+	Signs []base.Sign  `json:"signs"`
+}
+
+func (rb RelayedBallot) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RelayedBallotJSONMarshaler{
+		BaseHinter: rb.BaseHinter,
+		Voter:      rb.voter,
+		Vote:       rb.vote,
+		Signs:      rb.signs,
+	})
+}
+
+type RelayedBallotJSONUnmarshaler struct {
+	Hint  hint.Hint         `json:"_hint"`
+	Voter string            `json:"voter"`
+	Vote  uint8             `json:"vote"`
+	Signs []json.RawMessage `json:"signs"`
+}
+
+func (rb *RelayedBallot) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of RelayedBallot")
+
+	var u RelayedBallotJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	signs := make([]base.Sign, len(u.Signs))
+	for i := range u.Signs {
+		var s base.BaseSign
+		if err := s.DecodeJSON(u.Signs[i], enc); err != nil {
+			return e.Wrap(err)
+		}
+
+		signs[i] = s
+	}
+
+	return rb.unpack(enc, u.Hint, u.Voter, u.Vote, signs)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// MarshalSignsBSON keeps the signs signed out of an operation, like relayed
+// ballots, as strings; the signed time is kept to verify the signs again.
+func MarshalSignsBSON(signs []base.Sign) []bson.M {
+	m := make([]bson.M, len(signs))
+	for i := range signs {
+		m[i] = bson.M{
+			"signer":    signs[i].Signer().String(),
+			"signature": signs[i].Signature().String(),
+			"signed_at": localtime.New(signs[i].SignedAt()).RFC3339(),
+		}
+	}
+
+	return m
+}
+
+type SignBSONUnmarshaler struct {
+	Signer    string `bson:"signer"`
+	Signature string `bson:"signature"`
+	SignedAt  string `bson:"signed_at"`
+}
+
+func UnmarshalSignsBSON(us []SignBSONUnmarshaler, enc encoder.Encoder) ([]base.Sign, error) {
+	signs := make([]base.Sign, len(us))
+	for i := range us {
+		signer, err := base.DecodePublickeyFromString(us[i].Signer, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		var signature base.Signature
+		if err := signature.UnmarshalText([]byte(us[i].Signature)); err != nil {
+			return nil, err
+		}
+
+		var signedAt localtime.Time
+		if err := signedAt.UnmarshalText([]byte(us[i].SignedAt)); err != nil {
+			return nil, err
+		}
+
+		signs[i] = base.NewBaseSign(signer, signature, signedAt.Time)
+	}
+
+	return signs, nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var SponsoredFeeHint = hint.MustNewHint("mitum-dao-sponsored-fee-v0.0.1")
+
+// SponsoredFee is the sum of the operation fees of an account sponsored by the dao
+// treasury for a proposal.
+type SponsoredFee struct {
+	hint.BaseHinter
+	account base.Address
+	amount  common.Big
+}
+
+func NewSponsoredFee(account base.Address, amount common.Big) SponsoredFee {
+	return SponsoredFee{
+		BaseHinter: hint.NewBaseHinter(SponsoredFeeHint),
+		account:    account,
+		amount:     amount,
+	}
+}
+
+func (sf SponsoredFee) Hint() hint.Hint {
+	return sf.BaseHinter.Hint()
+}
+
+func (sf SponsoredFee) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid SponsoredFee")
+
+	if err := sf.BaseHinter.IsValid(SponsoredFeeHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, sf.account, sf.amount); err != nil {
+		return e.Wrap(err)
+	}
+
+	if sf.amount.Compare(common.ZeroBig) < 0 {
+		return e.Wrap(errors.Errorf("negative sponsored fee amount, %v", sf.amount))
+	}
+
+	return nil
+}
+
+func (sf SponsoredFee) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		sf.account.Bytes(),
+		sf.amount.Bytes(),
+	)
+}
+
+func (sf SponsoredFee) Account() base.Address {
+	return sf.account
+}
+
+func (sf SponsoredFee) Amount() common.Big {
+	return sf.amount
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (sf SponsoredFee) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":   sf.Hint().String(),
+			"account": sf.account,
+			"amount":  sf.amount.String(),
+		},
+	)
+}
+
+type SponsoredFeeBSONUnmarshaler struct {
+	Hint    string `bson:"_hint"`
+	Account string `bson:"account"`
+	Amount  string `bson:"amount"`
+}
+
+func (sf *SponsoredFee) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of SponsoredFee")
+
+	var u SponsoredFeeBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return sf.unpack(enc, ht, u.Account, u.Amount)
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (sf *SponsoredFee) unpack(enc encoder.Encoder, ht hint.Hint, ac, am string) error {
+	e := util.StringError("failed to unmarshal SponsoredFee")
+
+	sf.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch ad, err := base.DecodeAddress(ac, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		sf.account = ad
+	}
+
+	big, err := common.NewBigFromString(am)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	sf.amount = big
+
+	return nil
+}
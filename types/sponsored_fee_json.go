@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type SponsoredFeeJSONMarshaler struct {
+	hint.BaseHinter
+	Account base.Address `json:"account"`
+	Amount  string       `json:"amount"`
+}
+
+func (sf SponsoredFee) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(SponsoredFeeJSONMarshaler{
+		BaseHinter: sf.BaseHinter,
+		Account:    sf.account,
+		Amount:     sf.amount.String(),
+	})
+}
+
+type SponsoredFeeJSONUnmarshaler struct {
+	Hint    hint.Hint `json:"_hint"`
+	Account string    `json:"account"`
+	Amount  string    `json:"amount"`
+}
+
+func (sf *SponsoredFee) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of SponsoredFee")
+
+	var u SponsoredFeeJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return sf.unpack(enc, u.Hint, u.Account, u.Amount)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"sort"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var TallyRoundHint = hint.MustNewHint("mitum-dao-tally-round-v0.0.1")
+
+// TallyRound is a counting round of a ranked-choice tally. counts holds the
+// weight of the ballots for each continuing option, exhausted the weight of the
+// ballots left without a continuing option and eliminated the options dropped
+// after the round.
+type TallyRound struct {
+	hint.BaseHinter
+	counts     map[uint8]common.Big
+	exhausted  common.Big
+	eliminated []uint8
+}
+
+func NewTallyRound(counts map[uint8]common.Big, exhausted common.Big, eliminated []uint8) TallyRound {
+	return TallyRound{
+		BaseHinter: hint.NewBaseHinter(TallyRoundHint),
+		counts:     counts,
+		exhausted:  exhausted,
+		eliminated: eliminated,
+	}
+}
+
+func (r TallyRound) Hint() hint.Hint {
+	return r.BaseHinter.Hint()
+}
+
+func (r TallyRound) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid TallyRound")
+
+	if err := r.BaseHinter.IsValid(TallyRoundHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.exhausted.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	for _, o := range r.eliminated {
+		if _, found := r.counts[o]; !found {
+			return e.Wrap(errors.Errorf("eliminated option not counted in the round, %d", o))
+		}
+	}
+
+	return nil
+}
+
+func (r TallyRound) Bytes() []byte {
+	options := make([]int, 0, len(r.counts))
+	for o := range r.counts {
+		options = append(options, int(o))
+	}
+	sort.Ints(options)
+
+	bs := make([][]byte, len(options))
+	for i, o := range options {
+		bs[i] = util.ConcatBytesSlice(util.Uint8ToBytes(uint8(o)), r.counts[uint8(o)].Bytes())
+	}
+
+	return util.ConcatBytesSlice(
+		util.ConcatBytesSlice(bs...),
+		r.exhausted.Bytes(),
+		r.eliminated,
+	)
+}
+
+func (r TallyRound) Counts() map[uint8]common.Big {
+	return r.counts
+}
+
+func (r TallyRound) Exhausted() common.Big {
+	return r.exhausted
+}
+
+func (r TallyRound) Eliminated() []uint8 {
+	return r.eliminated
+}
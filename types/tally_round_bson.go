@@ -0,0 +1,42 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r TallyRound) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":      r.Hint().String(),
+			"counts":     allocationsToStrings(r.counts),
+			"exhausted":  r.exhausted.String(),
+			"eliminated": optionsToUints(r.eliminated),
+		},
+	)
+}
+
+type TallyRoundBSONUnmarshaler struct {
+	Hint       string           `bson:"_hint"`
+	Counts     map[uint8]string `bson:"counts"`
+	Exhausted  string           `bson:"exhausted"`
+	Eliminated []uint           `bson:"eliminated"`
+}
+
+func (r *TallyRound) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TallyRound")
+
+	var u TallyRoundBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.Counts, u.Exhausted, u.Eliminated)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+func (r *TallyRound) unpack(_ encoder.Encoder, ht hint.Hint, counts map[uint8]string, ex string, el []uint) error {
+	e := util.StringError("failed to unmarshal TallyRound")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+
+	cs, err := allocationsFromStrings(counts)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	if cs == nil {
+		cs = map[uint8]common.Big{}
+	}
+	r.counts = cs
+
+	big, err := common.NewBigFromString(ex)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	r.exhausted = big
+
+	eliminated := make([]uint8, len(el))
+	for i, o := range el {
+		if o > 255 {
+			return e.Wrap(errors.Errorf("eliminated option out of range, %d", o))
+		}
+
+		eliminated[i] = uint8(o)
+	}
+	r.eliminated = eliminated
+
+	return nil
+}
+
+func optionsToUints(options []uint8) []uint {
+	us := make([]uint, len(options))
+	for i, o := range options {
+		us[i] = uint(o)
+	}
+
+	return us
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type TallyRoundJSONMarshaler struct {
+	hint.BaseHinter
+	Counts     map[uint8]string `json:"counts"`
+	Exhausted  string           `json:"exhausted"`
+	Eliminated []uint           `json:"eliminated"`
+}
+
+func (r TallyRound) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TallyRoundJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Counts:     allocationsToStrings(r.counts),
+		Exhausted:  r.exhausted.String(),
+		Eliminated: optionsToUints(r.eliminated),
+	})
+}
+
+type TallyRoundJSONUnmarshaler struct {
+	Hint       hint.Hint        `json:"_hint"`
+	Counts     map[uint8]string `json:"counts"`
+	Exhausted  string           `json:"exhausted"`
+	Eliminated []uint           `json:"eliminated"`
+}
+
+func (r *TallyRound) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of TallyRound")
+
+	var u TallyRoundJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.Counts, u.Exhausted, u.Eliminated)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var VetoRecordHint = hint.MustNewHint("mitum-dao-veto-record-v0.0.1")
+
+// VetoRecord is the veto of a guardian cast during the execution delay of a
+// proposal. vetoedAt is the time of the veto in the period unit of the policy.
+type VetoRecord struct {
+	hint.BaseHinter
+	guardian base.Address
+	vetoedAt uint64
+}
+
+func NewVetoRecord(guardian base.Address, vetoedAt uint64) VetoRecord {
+	return VetoRecord{
+		BaseHinter: hint.NewBaseHinter(VetoRecordHint),
+		guardian:   guardian,
+		vetoedAt:   vetoedAt,
+	}
+}
+
+func (r VetoRecord) Hint() hint.Hint {
+	return r.BaseHinter.Hint()
+}
+
+func (r VetoRecord) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid VetoRecord")
+
+	if err := r.BaseHinter.IsValid(VetoRecordHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := r.guardian.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if r.vetoedAt == 0 {
+		return e.Wrap(errors.Errorf("zero vetoed time"))
+	}
+
+	return nil
+}
+
+func (r VetoRecord) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		r.guardian.Bytes(),
+		util.Uint64ToBytes(r.vetoedAt),
+	)
+}
+
+func (r VetoRecord) Guardian() base.Address {
+	return r.guardian
+}
+
+func (r VetoRecord) VetoedAt() uint64 {
+	return r.vetoedAt
+}
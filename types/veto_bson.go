@@ -0,0 +1,40 @@
+package types
+
+import (
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r VetoRecord) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     r.Hint().String(),
+			"guardian":  r.guardian,
+			"vetoed_at": r.vetoedAt,
+		},
+	)
+}
+
+type VetoRecordBSONUnmarshaler struct {
+	Hint     string `bson:"_hint"`
+	Guardian string `bson:"guardian"`
+	VetoedAt uint64 `bson:"vetoed_at"`
+}
+
+func (r *VetoRecord) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of VetoRecord")
+
+	var u VetoRecordBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.Guardian, u.VetoedAt)
+}
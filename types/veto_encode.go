@@ -0,0 +1,25 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *VetoRecord) unpack(enc encoder.Encoder, ht hint.Hint, ga string, at uint64) error {
+	e := util.StringError("failed to unmarshal VetoRecord")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+
+	switch a, err := base.DecodeAddress(ga, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.guardian = a
+	}
+
+	r.vetoedAt = at
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type VetoRecordJSONMarshaler struct {
+	hint.BaseHinter
+	Guardian base.Address `json:"guardian"`
+	VetoedAt uint64       `json:"vetoed_at"`
+}
+
+func (r VetoRecord) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(VetoRecordJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Guardian:   r.guardian,
+		VetoedAt:   r.vetoedAt,
+	})
+}
+
+type VetoRecordJSONUnmarshaler struct {
+	Hint     hint.Hint `json:"_hint"`
+	Guardian string    `json:"guardian"`
+	VetoedAt uint64    `json:"vetoed_at"`
+}
+
+func (r *VetoRecord) DecodeJSON(b []byte, enc encoder.Encoder) error {
+	e := util.StringError("failed to decode json of VetoRecord")
+
+	var u VetoRecordJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.Guardian, u.VetoedAt)
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/json"
+	"sort"
+	"strings"
 
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"github.com/ProtoconNet/mitum2/base"
@@ -17,10 +19,15 @@ var (
 
 type VotingPower struct {
 	hint.BaseHinter
-	account base.Address
-	voted   bool
-	voteFor uint8
-	amount  common.Big
+	account     base.Address
+	voted       bool
+	voteFor     uint8
+	amount      common.Big
+	weight      common.Big
+	allocations map[uint8]common.Big
+	ballot      Ballot
+	commitment  string
+	ciphertexts []string
 }
 
 func NewVotingPower(account base.Address, votingPower common.Big) VotingPower {
@@ -30,6 +37,7 @@ func NewVotingPower(account base.Address, votingPower common.Big) VotingPower {
 		voted:      false,
 		voteFor:    0,
 		amount:     votingPower,
+		weight:     votingPower,
 	}
 }
 
@@ -44,7 +52,17 @@ func (vp VotingPower) IsValid([]byte) error {
 		return e.Wrap(err)
 	}
 
-	if err := util.CheckIsValiders(nil, false, vp.account, vp.amount); err != nil {
+	if err := util.CheckIsValiders(nil, false, vp.account, vp.amount, vp.weight); err != nil {
+		return e.Wrap(err)
+	}
+
+	for o, am := range vp.allocations {
+		if !am.OverZero() {
+			return e.Wrap(errors.Errorf("allocation for option %d must be over zero, %q", o, am))
+		}
+	}
+
+	if err := vp.ballot.IsValid(nil); err != nil {
 		return e.Wrap(err)
 	}
 
@@ -57,11 +75,27 @@ func (vp VotingPower) Bytes() []byte {
 		v = 1
 	}
 
+	options := make([]int, 0, len(vp.allocations))
+	for o := range vp.allocations {
+		options = append(options, int(o))
+	}
+	sort.Ints(options)
+
+	bs := make([][]byte, len(options))
+	for i, o := range options {
+		bs[i] = util.ConcatBytesSlice(util.Uint8ToBytes(uint8(o)), vp.allocations[uint8(o)].Bytes())
+	}
+
 	return util.ConcatBytesSlice(
 		[]byte{byte(v)},
 		util.Uint8ToBytes(vp.voteFor),
 		vp.account.Bytes(),
 		vp.amount.Bytes(),
+		vp.weight.Bytes(),
+		util.ConcatBytesSlice(bs...),
+		vp.ballot.Bytes(),
+		[]byte(vp.commitment),
+		[]byte(strings.Join(vp.ciphertexts, ",")),
 	)
 }
 
@@ -77,6 +111,16 @@ func (vp *VotingPower) SetAmount(amount common.Big) {
 	vp.amount = amount
 }
 
+// Weight returns the effective weight of the voting power counted into the
+// result, see VotingWeightMode.
+func (vp VotingPower) Weight() common.Big {
+	return vp.weight
+}
+
+func (vp *VotingPower) SetWeight(weight common.Big) {
+	vp.weight = weight
+}
+
 func (vp VotingPower) Voted() bool {
 	return vp.voted
 }
@@ -93,15 +137,136 @@ func (vp *VotingPower) SetVoteFor(voteFor uint8) {
 	vp.voteFor = voteFor
 }
 
+// Allocations returns the split of the voting power over options; it is empty
+// unless the voter split the vote.
+func (vp VotingPower) Allocations() map[uint8]common.Big {
+	return vp.allocations
+}
+
+func (vp *VotingPower) SetAllocations(allocations map[uint8]common.Big) {
+	vp.allocations = allocations
+}
+
+// Ballot returns the options of an approval or a ranked-choice vote.
+func (vp VotingPower) Ballot() Ballot {
+	return vp.ballot
+}
+
+func (vp *VotingPower) SetBallot(ballot Ballot) {
+	vp.ballot = ballot
+}
+
+// Commitment returns the vote commitment of commit-reveal voting, see
+// NewVoteCommitment. The vote is not counted until it is revealed.
+func (vp VotingPower) Commitment() string {
+	return vp.commitment
+}
+
+func (vp *VotingPower) SetCommitment(commitment string) {
+	vp.commitment = commitment
+}
+
+// Ciphertexts returns the encrypted vote of encrypted voting, see
+// EncryptedBallot. The vote is counted only by the decrypted tally.
+func (vp VotingPower) Ciphertexts() []string {
+	return vp.ciphertexts
+}
+
+func (vp *VotingPower) SetCiphertexts(ciphertexts []string) {
+	vp.ciphertexts = ciphertexts
+}
+
+// Encrypted returns true when the vote is an encrypted ballot.
+func (vp VotingPower) Encrypted() bool {
+	return len(vp.ciphertexts) > 0
+}
+
+// Unrevealed returns true when the vote is committed but not revealed.
+func (vp VotingPower) Unrevealed() bool {
+	return len(vp.commitment) > 0 && !vp.voted
+}
+
+// VotedAmounts returns the voting power counted for each option.
+func (vp VotingPower) VotedAmounts() map[uint8]common.Big {
+	if !vp.voted {
+		return map[uint8]common.Big{}
+	}
+
+	if len(vp.allocations) < 1 {
+		return map[uint8]common.Big{vp.voteFor: vp.amount}
+	}
+
+	amounts := make(map[uint8]common.Big, len(vp.allocations))
+	for o, am := range vp.allocations {
+		amounts[o] = am
+	}
+
+	return amounts
+}
+
+// VotedWeights returns the effective weight counted for each option. The
+// weight of a split vote is divided in proportion to the allocations. An
+// approval ballot counts the whole weight for every approved option and a
+// ranked-choice ballot counts it for the first preference.
+func (vp VotingPower) VotedWeights(method VotingMethod) map[uint8]common.Big {
+	if !vp.voted {
+		return map[uint8]common.Big{}
+	}
+
+	if len(vp.ballot) > 0 {
+		if method == VotingRankedChoice {
+			return map[uint8]common.Big{vp.ballot[0]: vp.weight}
+		}
+
+		weights := make(map[uint8]common.Big, len(vp.ballot))
+		for _, o := range vp.ballot {
+			weights[o] = vp.weight
+		}
+
+		return weights
+	}
+
+	if len(vp.allocations) < 1 {
+		return map[uint8]common.Big{vp.voteFor: vp.weight}
+	}
+
+	weights := make(map[uint8]common.Big, len(vp.allocations))
+	for o, am := range vp.allocations {
+		if vp.weight.Equal(vp.amount) {
+			weights[o] = am
+		} else {
+			weights[o] = am.Mul(vp.weight).Div(vp.amount)
+		}
+	}
+
+	return weights
+}
+
 var (
 	VotingPowerBoxHint = hint.MustNewHint("mitum-dao-voting-power-box-v0.0.1")
 )
 
+// ApprovalRuleQuorum and ApprovalRuleTurnout are the rules deciding the tally
+// without approval threshold. The rule of an approval threshold is its calldata
+// type, see ApprovalThresholds. ApprovalRuleCancel and ApprovalRuleVeto are the
+// rules of the results of the proposals canceled by the proposer and vetoed by
+// the guardians. ApprovalRuleDecryption is the rule of the encrypted proposals
+// canceled as their tally is not decrypted until the Execute period.
+const (
+	ApprovalRuleQuorum     = "quorum"
+	ApprovalRuleTurnout    = "turnout"
+	ApprovalRuleCancel     = "cancel"
+	ApprovalRuleVeto       = "veto"
+	ApprovalRuleDecryption = "decryption"
+)
+
 type VotingPowerBox struct {
 	hint.BaseHinter
 	total        common.Big
 	votingPowers map[string]VotingPower
 	result       map[uint8]common.Big
+	rounds       []TallyRound
+	rule         string
 }
 
 func NewVotingPowerBox(total common.Big, votingPowers map[string]VotingPower) VotingPowerBox {
@@ -133,11 +298,17 @@ func (vp VotingPowerBox) IsValid([]byte) error {
 		return e.Wrap(errors.Errorf("invalid voting power total, %q != %q", total, vp.total))
 	}
 
+	for _, r := range vp.rounds {
+		if err := r.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return nil
 }
 
 func (vp VotingPowerBox) Bytes() []byte {
-	bs := make([][]byte, 3)
+	bs := make([][]byte, len(vp.rounds)+4)
 	bs[0] = vp.total.Bytes()
 	if vp.votingPowers != nil {
 		votingPowers, _ := json.Marshal(vp.votingPowers)
@@ -153,6 +324,12 @@ func (vp VotingPowerBox) Bytes() []byte {
 		bs[2] = []byte{}
 	}
 
+	bs[3] = []byte(vp.rule)
+
+	for i, r := range vp.rounds {
+		bs[i+4] = r.Bytes()
+	}
+
 	return util.ConcatBytesSlice(bs...)
 }
 
@@ -164,6 +341,29 @@ func (vp VotingPowerBox) VotingPowers() map[string]VotingPower {
 	return vp.votingPowers
 }
 
+// TotalWeight returns the sum of the effective weights of all voting powers.
+func (vp VotingPowerBox) TotalWeight() common.Big {
+	total := common.ZeroBig
+	for _, v := range vp.votingPowers {
+		total = total.Add(v.Weight())
+	}
+
+	return total
+}
+
+// Unrevealed returns the effective weights of the committed votes not revealed
+// in commit-reveal voting; they are not counted into the result.
+func (vp VotingPowerBox) Unrevealed() map[string]common.Big {
+	unrevealed := map[string]common.Big{}
+	for k, v := range vp.votingPowers {
+		if v.Unrevealed() {
+			unrevealed[k] = v.Weight()
+		}
+	}
+
+	return unrevealed
+}
+
 func (vp VotingPowerBox) Result() map[uint8]common.Big {
 	return vp.result
 }
@@ -179,3 +379,55 @@ func (vp *VotingPowerBox) SetVotingPowers(votingPowers map[string]VotingPower) {
 func (vp *VotingPowerBox) SetResult(result map[uint8]common.Big) {
 	vp.result = result
 }
+
+// Leader returns the option of the highest result except the abstain option.
+// It returns false when no option leads alone.
+func (vp VotingPowerBox) Leader(abstain uint8) (uint8, bool) {
+	var leader uint8
+	var leading bool
+	top := common.ZeroBig
+
+	for o, am := range vp.result {
+		switch c := am.Compare(top); {
+		case o == abstain || !am.OverZero() || c < 0:
+		case c == 0:
+			leading = false
+		default:
+			leader, leading, top = o, true, am
+		}
+	}
+
+	return leader, leading
+}
+
+// Winner returns the winning option of the tallied result; the option leading
+// alone in the last ranked-choice round or else the leader of the result.
+func (vp VotingPowerBox) Winner(abstain uint8) (uint8, bool) {
+	if len(vp.rounds) < 1 {
+		return vp.Leader(abstain)
+	}
+
+	last := NewVotingPowerBox(vp.total, nil)
+	last.SetResult(vp.rounds[len(vp.rounds)-1].Counts())
+
+	return last.Leader(abstain)
+}
+
+// Rounds returns the counting rounds of a ranked-choice tally.
+func (vp VotingPowerBox) Rounds() []TallyRound {
+	return vp.rounds
+}
+
+func (vp *VotingPowerBox) SetRounds(rounds []TallyRound) {
+	vp.rounds = rounds
+}
+
+// Rule returns the rule which decided the tally result; the approval threshold
+// calldata type, ApprovalRuleQuorum or ApprovalRuleTurnout.
+func (vp VotingPowerBox) Rule() string {
+	return vp.rule
+}
+
+func (vp *VotingPowerBox) SetRule(rule string) {
+	vp.rule = rule
+}
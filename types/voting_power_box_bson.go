@@ -18,16 +18,26 @@ func (vp VotingPower) MarshalBSON() ([]byte, error) {
 			"voted":        vp.voted,
 			"vote_for":     vp.voteFor,
 			"voting_power": vp.amount,
+			"weight":       vp.weight,
+			"allocations":  allocationsToStrings(vp.allocations),
+			"ballot":       vp.ballot,
+			"commitment":   vp.commitment,
+			"ciphertexts":  vp.ciphertexts,
 		},
 	)
 }
 
 type VotingPowerBSONUnmarshaler struct {
-	Hint        string `bson:"_hint"`
-	Account     string `bson:"account"`
-	Voted       bool   `bson:"voted"`
-	VoteFor     uint8  `bson:"vote_for"`
-	VotingPower string `bson:"voting_power"`
+	Hint        string           `bson:"_hint"`
+	Account     string           `bson:"account"`
+	Voted       bool             `bson:"voted"`
+	VoteFor     uint8            `bson:"vote_for"`
+	VotingPower string           `bson:"voting_power"`
+	Weight      string           `bson:"weight"`
+	Allocations map[uint8]string `bson:"allocations"`
+	Ballot      Ballot           `bson:"ballot"`
+	Commitment  string           `bson:"commitment"`
+	Ciphertexts []string         `bson:"ciphertexts"`
 }
 
 func (vp *VotingPower) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -57,9 +67,24 @@ func (vp *VotingPower) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 	vp.amount = big
+
+	weight, err := weightFromString(u.Weight, big)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	vp.weight = weight
 	vp.voted = u.Voted
 	vp.voteFor = u.VoteFor
 
+	allocations, err := allocationsFromStrings(u.Allocations)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	vp.allocations = allocations
+	vp.ballot = u.Ballot
+	vp.commitment = u.Commitment
+	vp.ciphertexts = u.Ciphertexts
+
 	return nil
 }
 
@@ -70,6 +95,8 @@ func (vp VotingPowerBox) MarshalBSON() ([]byte, error) {
 			"total":         vp.total.String(),
 			"voting_powers": vp.votingPowers,
 			"result":        vp.result,
+			"rounds":        vp.rounds,
+			"rule":          vp.rule,
 		},
 	)
 }
@@ -79,6 +106,8 @@ type VotingPowerBoxBSONUnmarshaler struct {
 	Total        string           `bson:"total"`
 	VotingPowers bson.Raw         `bson:"voting_powers"`
 	Result       map[uint8]string `bson:"result"`
+	Rounds       bson.Raw         `bson:"rounds"`
+	Rule         string           `bson:"rule"`
 }
 
 func (vp *VotingPowerBox) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -133,5 +162,12 @@ func (vp *VotingPowerBox) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 	vp.result = result
 
+	rounds, err := decodeTallyRounds(enc, u.Rounds)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	vp.rounds = rounds
+	vp.rule = u.Rule
+
 	return nil
 }
@@ -12,7 +12,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (vp *VotingPowerBox) unpack(enc encoder.Encoder, ht hint.Hint, st string, bvp []byte, bre []byte) error {
+func (vp *VotingPowerBox) unpack(enc encoder.Encoder, ht hint.Hint, st string, bvp []byte, bre []byte, brd []byte, rl string) error {
 	e := util.StringError("failed to unmarshal VotingPowerBox")
 
 	vp.BaseHinter = hint.NewBaseHinter(ht)
@@ -63,5 +63,76 @@ func (vp *VotingPowerBox) unpack(enc encoder.Encoder, ht hint.Hint, st string, b
 	}
 	vp.result = result
 
+	rounds, err := decodeTallyRounds(enc, brd)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	vp.rounds = rounds
+	vp.rule = rl
+
 	return nil
 }
+
+func decodeTallyRounds(enc encoder.Encoder, b []byte) ([]TallyRound, error) {
+	if len(b) < 1 {
+		return nil, nil
+	}
+
+	hr, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	rounds := make([]TallyRound, len(hr))
+	for i, r := range hr {
+		j, ok := r.(TallyRound)
+		if !ok {
+			return nil, errors.Errorf("expected TallyRound, not %T", r)
+		}
+
+		rounds[i] = j
+	}
+
+	return rounds, nil
+}
+
+func allocationsToStrings(allocations map[uint8]common.Big) map[uint8]string {
+	if len(allocations) < 1 {
+		return nil
+	}
+
+	m := make(map[uint8]string, len(allocations))
+	for o, am := range allocations {
+		m[o] = am.String()
+	}
+
+	return m
+}
+
+func allocationsFromStrings(m map[uint8]string) (map[uint8]common.Big, error) {
+	if len(m) < 1 {
+		return nil, nil
+	}
+
+	allocations := make(map[uint8]common.Big, len(m))
+	for o, v := range m {
+		big, err := common.NewBigFromString(v)
+		if err != nil {
+			return nil, err
+		}
+
+		allocations[o] = big
+	}
+
+	return allocations, nil
+}
+
+// weightFromString decodes the effective weight; voting powers stored without
+// a weight are weighted linearly.
+func weightFromString(s string, amount common.Big) (common.Big, error) {
+	if len(s) < 1 {
+		return amount, nil
+	}
+
+	return common.NewBigFromString(s)
+}
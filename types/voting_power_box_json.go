@@ -12,10 +12,15 @@ import (
 
 type VotingPowerJSONMarshaler struct {
 	hint.BaseHinter
-	Account     base.Address `json:"account"`
-	Voted       bool         `json:"voted"`
-	VoteFor     uint8        `json:"vote_for"`
-	VotingPower string       `json:"voting_power"`
+	Account     base.Address     `json:"account"`
+	Voted       bool             `json:"voted"`
+	VoteFor     uint8            `json:"vote_for"`
+	VotingPower string           `json:"voting_power"`
+	Weight      string           `json:"weight"`
+	Allocations map[uint8]string `json:"allocations,omitempty"`
+	Ballot      Ballot           `json:"ballot,omitempty"`
+	Commitment  string           `json:"commitment,omitempty"`
+	Ciphertexts []string         `json:"ciphertexts,omitempty"`
 }
 
 func (vp VotingPower) MarshalJSON() ([]byte, error) {
@@ -25,14 +30,24 @@ func (vp VotingPower) MarshalJSON() ([]byte, error) {
 		Voted:       vp.voted,
 		VoteFor:     vp.voteFor,
 		VotingPower: vp.amount.String(),
+		Weight:      vp.weight.String(),
+		Allocations: allocationsToStrings(vp.allocations),
+		Ballot:      vp.ballot,
+		Commitment:  vp.commitment,
+		Ciphertexts: vp.ciphertexts,
 	})
 }
 
 type VotingPowerJSONUnmarshaler struct {
-	Account     string `json:"account"`
-	Voted       bool   `json:"voted"`
-	VoteFor     uint8  `json:"vote_for"`
-	VotingPower string `json:"voting_power"`
+	Account     string           `json:"account"`
+	Voted       bool             `json:"voted"`
+	VoteFor     uint8            `json:"vote_for"`
+	VotingPower string           `json:"voting_power"`
+	Weight      string           `json:"weight"`
+	Allocations map[uint8]string `json:"allocations"`
+	Ballot      Ballot           `json:"ballot"`
+	Commitment  string           `json:"commitment"`
+	Ciphertexts []string         `json:"ciphertexts"`
 }
 
 func (vp *VotingPower) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -55,25 +70,48 @@ func (vp *VotingPower) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 	vp.amount = big
+
+	weight, err := weightFromString(u.Weight, big)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	vp.weight = weight
 	vp.voted = u.Voted
 	vp.voteFor = u.VoteFor
 
+	allocations, err := allocationsFromStrings(u.Allocations)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	vp.allocations = allocations
+	vp.ballot = u.Ballot
+	vp.commitment = u.Commitment
+	vp.ciphertexts = u.Ciphertexts
+
 	return nil
 }
 
 type VotingPowerBoxJSONMarshaler struct {
 	hint.BaseHinter
 	Total        string                 `json:"total"`
+	TotalWeight  string                 `json:"total_weight"`
 	VotingPowers map[string]VotingPower `json:"voting_powers"`
 	Result       map[uint8]common.Big   `json:"result"`
+	Rounds       []TallyRound           `json:"rounds,omitempty"`
+	Rule         string                 `json:"rule,omitempty"`
+	Unrevealed   map[string]common.Big  `json:"unrevealed,omitempty"`
 }
 
 func (vp VotingPowerBox) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(VotingPowerBoxJSONMarshaler{
 		BaseHinter:   vp.BaseHinter,
 		Total:        vp.total.String(),
+		TotalWeight:  vp.TotalWeight().String(),
 		VotingPowers: vp.votingPowers,
 		Result:       vp.result,
+		Rounds:       vp.rounds,
+		Rule:         vp.rule,
+		Unrevealed:   vp.Unrevealed(),
 	})
 }
 
@@ -82,6 +120,8 @@ type VotingPowerBoxJSONUnmarshaler struct {
 	Total        string          `json:"total"`
 	VotingPowers json.RawMessage `json:"voting_powers"`
 	Result       json.RawMessage `json:"result"`
+	Rounds       json.RawMessage `json:"rounds"`
+	Rule         string          `json:"rule"`
 }
 
 func (vp *VotingPowerBox) DecodeJSON(b []byte, enc encoder.Encoder) error {
@@ -92,5 +132,5 @@ func (vp *VotingPowerBox) DecodeJSON(b []byte, enc encoder.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return vp.unpack(enc, u.Hint, u.Total, u.VotingPowers, u.Result)
+	return vp.unpack(enc, u.Hint, u.Total, u.VotingPowers, u.Result, u.Rounds, u.Rule)
 }